@@ -2,31 +2,162 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/truthtable/backend-go/internal/auth"
 	"github.com/truthtable/backend-go/internal/config"
 	"github.com/truthtable/backend-go/internal/grpc"
+	"github.com/truthtable/backend-go/internal/metrics"
 	"github.com/truthtable/backend-go/internal/proxy"
+	"github.com/truthtable/backend-go/internal/server"
 	"github.com/truthtable/backend-go/internal/websocket"
 	"github.com/truthtable/backend-go/internal/worker"
 )
 
+// stack holds every piece of request-handling state that a SIGHUP config
+// reload rebuilds from scratch: the audit client, worker pool, proxy
+// handler, and Gin routers. wsHub outlives reloads (it has no stop
+// mechanism) and the server.Server's listeners outlive reloads too, so
+// neither is part of the stack.
+type stack struct {
+	auditClient  *grpc.AuditClient
+	auditBackend worker.AuditBackend
+	router       http.Handler
+	wsRouter     http.Handler
+}
+
 func main() {
 	cfg := config.Load()
 	log.Printf("🚀 Starting TruthTable Proxy")
 	log.Printf("   Server Port: %d", cfg.ServerPort)
 	log.Printf("   WebSocket Port: %d", cfg.WSPort)
+
+	if cfg.LogLevel != "debug" {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	wsHub := websocket.NewHubWithConfig(websocket.HubConfig{
+		MaxMessageBytes:        cfg.WSMaxMessageBytes,
+		ReadBufferSize:         cfg.WSReadBufferSize,
+		WriteBufferSize:        cfg.WSWriteBufferSize,
+		ChunkReassemblyTimeout: cfg.WSChunkReassemblyTimeout,
+	})
+	go wsHub.Run()
+	log.Printf("✓ WebSocket hub started")
+
+	srv, err := server.Listen(cfg)
+	if err != nil {
+		log.Fatalf("Failed to acquire listeners: %v", err)
+	}
+	switch {
+	case len(cfg.ACMEDomains) > 0:
+		log.Printf("✓ TLS termination enabled via ACME for %v", cfg.ACMEDomains)
+	case cfg.TLSCertFile != "" && cfg.TLSKeyFile != "":
+		log.Printf("✓ TLS termination enabled with static cert %s", cfg.TLSCertFile)
+	}
+	if cfg.TrustProxyProtocol {
+		log.Printf("✓ PROXY protocol decoding enabled on HTTP and WebSocket listeners")
+	}
+
+	st := buildStack(cfg, wsHub)
+
+	log.Printf("✅ TruthTable Proxy is ready!")
+	log.Printf("   Send requests to: http://localhost:%d/v1/chat/completions", cfg.ServerPort)
+	log.Printf("   Dashboard WebSocket: ws://localhost:%d/ws", cfg.WSPort)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGUSR2)
+
+	for {
+		ctx, cancel := context.WithCancel(context.Background())
+		runDone := make(chan error, 1)
+		go func(st *stack) {
+			runDone <- srv.Run(ctx, st.router, st.wsRouter, cfg.ReadTimeout, cfg.WriteTimeout, cfg.ShutdownTimeout)
+		}(st)
+
+		rebuild := false
+		var newCfg *config.Config
+
+	waitForSignal:
+		for sig := range sigCh {
+			switch sig {
+			case syscall.SIGHUP:
+				log.Println("🔄 SIGHUP received, rebuilding the proxy stack with reloaded config")
+				newCfg = config.Load()
+				rebuild = true
+				break waitForSignal
+			case syscall.SIGUSR2:
+				log.Println("🔁 SIGUSR2 received, handing listeners off to a new process for a hot restart")
+				if _, err := srv.Reexec(); err != nil {
+					log.Printf("Hot restart failed, continuing to serve on this process: %v", err)
+					continue
+				}
+				log.Println("🛑 Draining this process's connections so the new one can take over...")
+				break waitForSignal
+			default:
+				break waitForSignal
+			}
+		}
+
+		cancel()
+		if err := <-runDone; err != nil {
+			log.Printf("Server run error: %v", err)
+		}
+
+		st.auditBackend.Stop()
+		if st.auditClient != nil {
+			st.auditClient.Close()
+		}
+
+		if !rebuild {
+			break
+		}
+
+		cfg = newCfg
+		if cfg.LogLevel == "debug" {
+			gin.SetMode(gin.DebugMode)
+		} else {
+			gin.SetMode(gin.ReleaseMode)
+		}
+		st = buildStack(cfg, wsHub)
+		log.Println("✓ Config reloaded; serving the rebuilt stack on the same listeners")
+	}
+
+	log.Println("✅ Servers stopped gracefully")
+}
+
+// buildStack constructs every piece of request-handling state that can
+// change on a SIGHUP config reload: the audit client, worker pool, proxy
+// handler, and Gin routers. It's safe to call more than once against the
+// same wsHub - the previous call's audit backend and client must already be
+// stopped/closed by the caller before the next rebuild takes over metrics
+// registration.
+func buildStack(cfg *config.Config, wsHub *websocket.Hub) *stack {
 	log.Printf("   Upstream URL: %s", cfg.UpstreamURL)
 	log.Printf("   gRPC Address: %s", cfg.GRPCAddress)
 
-	auditClient, err := grpc.NewAuditClient(cfg.GRPCAddress, cfg.GRPCTimeout)
+	var grpcOpts []grpc.ClientOption
+	if cfg.GRPCTLSEnabled {
+		grpcOpts = append(grpcOpts, grpc.WithTLS(grpc.TLSConfig{
+			CAFile:             cfg.GRPCTLSCAFile,
+			CertFile:           cfg.GRPCTLSCertFile,
+			KeyFile:            cfg.GRPCTLSKeyFile,
+			ServerNameOverride: cfg.GRPCTLSServerNameOverride,
+			InsecureSkipVerify: cfg.GRPCTLSInsecureSkipVerify,
+		}))
+	}
+	if cfg.GRPCAuthToken != "" {
+		grpcOpts = append(grpcOpts, grpc.WithStaticToken(cfg.GRPCAuthToken, cfg.GRPCTLSEnabled))
+	}
+
+	auditClient, err := grpc.NewAuditClient(cfg.GRPCAddress, cfg.GRPCTimeout, grpcOpts...)
 	if err != nil {
 		log.Printf("⚠️  Warning: Could not connect to audit engine: %v", err)
 		log.Printf("   Proxy will still work, but audits will be skipped")
@@ -34,24 +165,62 @@ func main() {
 		log.Printf("✓ Connected to audit engine at %s", cfg.GRPCAddress)
 	}
 
-	wsHub := websocket.NewHub()
-	go wsHub.Run()
-	log.Printf("✓ WebSocket hub started")
-
-	workerPool := worker.NewPool(cfg.WorkerCount, cfg.QueueSize, auditClient, wsHub)
-	go workerPool.Start()
-	log.Printf("✓ Worker pool started (%d workers, queue size %d)", cfg.WorkerCount, cfg.QueueSize)
+	var auditBackend worker.AuditBackend
+	switch cfg.AuditBackend {
+	case "grpc":
+		backpressure := worker.BackpressureBlock
+		if cfg.GRPCAuditBackpressure == "drop_oldest" {
+			backpressure = worker.BackpressureDropOldest
+		}
+		auditBackend = worker.NewGRPCPool(worker.GRPCPoolConfig{
+			BufferSize:      cfg.GRPCAuditBufferSize,
+			Concurrency:     cfg.GRPCAuditConcurrency,
+			Backpressure:    backpressure,
+			ShutdownTimeout: cfg.ShutdownTimeout,
+		}, auditClient, wsHub)
+		log.Printf("✓ gRPC audit backend configured (buffer %d, concurrency %d, backpressure %s)",
+			cfg.GRPCAuditBufferSize, cfg.GRPCAuditConcurrency, cfg.GRPCAuditBackpressure)
+	default:
+		jobStore, err := worker.NewFileJobStore(cfg.JobStoreDir)
+		if err != nil {
+			log.Fatalf("Failed to open job store at %s: %v", cfg.JobStoreDir, err)
+		}
+		auditBackend = worker.NewPool(worker.PoolConfig{
+			Workers:       cfg.WorkerCount,
+			QueueSize:     cfg.QueueSize,
+			Store:         jobStore,
+			SubmitTimeout: cfg.SubmitTimeout,
+			RetryPolicy: worker.RetryPolicy{
+				MaxAttempts: cfg.RetryMaxAttempts,
+				BaseDelay:   cfg.RetryBaseDelay,
+				MaxDelay:    cfg.RetryMaxDelay,
+			},
+		}, auditClient, wsHub)
+		log.Printf("✓ Local audit backend configured (%d workers, queue size %d)", cfg.WorkerCount, cfg.QueueSize)
+	}
+	go auditBackend.Start()
 
-	proxyHandler := proxy.NewHandler(cfg.UpstreamURL, workerPool)
-	log.Printf("✓ Proxy handler ready")
+	metrics.RegisterAuditBackend(cfg.AuditBackend, func() metrics.BackendSnapshot {
+		m := auditBackend.Metrics()
+		return metrics.BackendSnapshot{
+			QueueDepth:      m.QueueDepth,
+			InFlight:        m.InFlight,
+			RetryCount:      m.RetryCount,
+			DeadLetterCount: m.DeadLetterCount,
+			DroppedCount:    m.DroppedCount,
+		}
+	})
 
-	if cfg.LogLevel != "debug" {
-		gin.SetMode(gin.ReleaseMode)
-	}
+	proxyHandler := proxy.NewHandler(cfg.UpstreamURL, auditBackend)
+	proxyHandler.SetChatProvider(cfg.ChatProvider)
+	proxyHandler.SetTrustedProxies(cfg.TrustedProxies)
+	proxyHandler.SetWSHub(wsHub)
+	log.Printf("✓ Proxy handler ready (chat provider: %s, trusted proxies: %d)", cfg.ChatProvider, len(cfg.TrustedProxies))
 
 	router := gin.New()
 	router.Use(gin.Recovery())
 	router.Use(loggingMiddleware())
+	router.Use(metricsMiddleware())
 	router.Use(corsMiddleware())
 
 	router.GET("/health", func(c *gin.Context) {
@@ -62,18 +231,36 @@ func main() {
 		})
 	})
 
-	router.GET("/metrics", func(c *gin.Context) {
-		c.String(http.StatusOK, "# Metrics coming soon")
-	})
+	if cfg.MetricsAuthToken != "" {
+		router.GET("/metrics", auth.BearerOnly(cfg.MetricsAuthToken), gin.WrapH(metrics.Handler()))
+	} else {
+		router.GET("/metrics", gin.WrapH(metrics.Handler()))
+	}
+
+	v1 := router.Group("/v1")
+	v1beta := router.Group("/v1beta")
+	if cfg.AuthEnabled {
+		keyStore := auth.NewStaticKeyStore(cfg.AuthKeys)
+		limiter := auth.NewRateLimiter(auth.Limits{
+			RPS:         cfg.AuthRPS,
+			Burst:       cfg.AuthBurst,
+			MaxInFlight: cfg.AuthMaxInFlight,
+		})
+		v1.Use(auth.Middleware(keyStore, limiter))
+		v1beta.Use(auth.Middleware(keyStore, limiter))
+		log.Printf("✓ Bearer auth enabled on /v1 and /v1beta (%d keys)", len(cfg.AuthKeys))
+	}
 
 	// Main LLM API endpoints (intercept and audit)
-	router.POST("/v1/chat/completions", proxyHandler.HandleChatCompletion)
-	router.POST("/v1/completions", proxyHandler.HandleCompletion)
+	v1.POST("/chat/completions", proxyHandler.HandleChatCompletion)
+	v1.POST("/completions", proxyHandler.HandleCompletion)
+	v1.POST("/messages", proxyHandler.HandleLLMRequest)
+	v1beta.POST("/models/*model", proxyHandler.HandleLLMRequest)
 
 	// Other v1 endpoints - forward as-is without auditing
-	router.Any("/v1/models", proxyHandler.HandleGeneric)
-	router.Any("/v1/models/*model", proxyHandler.HandleGeneric)
-	router.Any("/v1/embeddings", proxyHandler.HandleGeneric)
+	v1.Any("/models", proxyHandler.HandleGeneric)
+	v1.Any("/models/*model", proxyHandler.HandleGeneric)
+	v1.Any("/embeddings", proxyHandler.HandleGeneric)
 
 	wsRouter := gin.New()
 	wsRouter.Use(gin.Recovery())
@@ -82,58 +269,12 @@ func main() {
 		websocket.ServeWS(wsHub, c.Writer, c.Request)
 	})
 
-	httpServer := &http.Server{
-		Addr:         fmt.Sprintf(":%d", cfg.ServerPort),
-		Handler:      router,
-		ReadTimeout:  cfg.ReadTimeout,
-		WriteTimeout: cfg.WriteTimeout,
-	}
-
-	wsServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", cfg.WSPort),
-		Handler: wsRouter,
+	return &stack{
+		auditClient:  auditClient,
+		auditBackend: auditBackend,
+		router:       router,
+		wsRouter:     wsRouter,
 	}
-
-	go func() {
-		log.Printf("🌐 HTTP server listening on :%d", cfg.ServerPort)
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("HTTP server error: %v", err)
-		}
-	}()
-
-	go func() {
-		log.Printf("🔌 WebSocket server listening on :%d", cfg.WSPort)
-		if err := wsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("WebSocket server error: %v", err)
-		}
-	}()
-
-	log.Printf("✅ TruthTable Proxy is ready!")
-	log.Printf("   Send requests to: http://localhost:%d/v1/chat/completions", cfg.ServerPort)
-	log.Printf("   Dashboard WebSocket: ws://localhost:%d/ws", cfg.WSPort)
-
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
-
-	log.Println("🛑 Shutting down servers...")
-
-	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
-	defer cancel()
-
-	if err := httpServer.Shutdown(ctx); err != nil {
-		log.Printf("HTTP server shutdown error: %v", err)
-	}
-	if err := wsServer.Shutdown(ctx); err != nil {
-		log.Printf("WebSocket server shutdown error: %v", err)
-	}
-
-	workerPool.Stop()
-	if auditClient != nil {
-		auditClient.Close()
-	}
-
-	log.Println("✅ Servers stopped gracefully")
 }
 
 func loggingMiddleware() gin.HandlerFunc {
@@ -147,6 +288,24 @@ func loggingMiddleware() gin.HandlerFunc {
 	}
 }
 
+// metricsMiddleware records every request's method, route, status, and
+// latency for the /metrics exposition. Handlers that know the upstream LLM
+// model (proxy.Handler's chat/completions routes) tag it via
+// c.Set("llm_model", ...) sometime during the request; that's read here
+// after c.Next() returns, regardless of when it was set.
+func metricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		path := c.FullPath()
+		if path == "" {
+			path = c.Request.URL.Path
+		}
+		metrics.ObserveHTTPRequest(c.Request.Method, path, strconv.Itoa(c.Writer.Status()), c.GetString("llm_model"), time.Since(start))
+	}
+}
+
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
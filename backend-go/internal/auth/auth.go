@@ -0,0 +1,47 @@
+// Package auth validates API callers against a configurable set of bearer
+// tokens and enforces per-key rate limits, so multi-tenant deployments get a
+// real access-control surface instead of the proxy's previously wide-open
+// CORS "*".
+package auth
+
+import "strings"
+
+// KeyStore resolves a bearer token to the principal (tenant/user identity)
+// it authenticates as. Authenticate returns ok=false for an unknown or
+// revoked key.
+type KeyStore interface {
+	Authenticate(key string) (principal string, ok bool)
+}
+
+// StaticKeyStore is a KeyStore backed by a fixed key->principal map, loaded
+// once at startup (or rebuilt wholesale on a SIGHUP config reload).
+type StaticKeyStore struct {
+	keys map[string]string
+}
+
+var _ KeyStore = (*StaticKeyStore)(nil)
+
+// NewStaticKeyStore builds a StaticKeyStore from key->principal pairs. keys
+// is copied, so the caller's map can be reused or mutated afterward.
+func NewStaticKeyStore(keys map[string]string) *StaticKeyStore {
+	copied := make(map[string]string, len(keys))
+	for k, v := range keys {
+		copied[k] = v
+	}
+	return &StaticKeyStore{keys: copied}
+}
+
+func (s *StaticKeyStore) Authenticate(key string) (string, bool) {
+	principal, ok := s.keys[key]
+	return principal, ok
+}
+
+// BearerToken extracts the token from an "Authorization: Bearer <token>"
+// header value, returning "" if header isn't a bearer token.
+func BearerToken(header string) string {
+	const prefix = "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(header, prefix)
+}
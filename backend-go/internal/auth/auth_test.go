@@ -0,0 +1,39 @@
+package auth
+
+import "testing"
+
+func TestBearerToken(t *testing.T) {
+	if got := BearerToken("Bearer abc123"); got != "abc123" {
+		t.Errorf("Expected 'abc123', got %q", got)
+	}
+	if got := BearerToken("Basic abc123"); got != "" {
+		t.Errorf("Expected empty string for a non-bearer header, got %q", got)
+	}
+	if got := BearerToken(""); got != "" {
+		t.Errorf("Expected empty string for an empty header, got %q", got)
+	}
+}
+
+func TestStaticKeyStoreAuthenticate(t *testing.T) {
+	store := NewStaticKeyStore(map[string]string{"key-1": "alice"})
+
+	principal, ok := store.Authenticate("key-1")
+	if !ok || principal != "alice" {
+		t.Errorf("Expected ('alice', true), got (%q, %v)", principal, ok)
+	}
+
+	if _, ok := store.Authenticate("unknown-key"); ok {
+		t.Error("Expected an unknown key to fail authentication")
+	}
+}
+
+func TestStaticKeyStoreCopiesInput(t *testing.T) {
+	keys := map[string]string{"key-1": "alice"}
+	store := NewStaticKeyStore(keys)
+
+	keys["key-1"] = "mutated"
+
+	if principal, _ := store.Authenticate("key-1"); principal != "alice" {
+		t.Errorf("Expected the store to be unaffected by later mutation of the input map, got %q", principal)
+	}
+}
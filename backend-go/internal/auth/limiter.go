@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// Limits bounds a single principal's request rate (RPS, with Burst allowed
+// in a single instant) and concurrent in-flight requests. A zero RPS or
+// MaxInFlight disables that particular bound.
+type Limits struct {
+	RPS         float64
+	Burst       int
+	MaxInFlight int
+}
+
+// RateLimiter enforces per-principal Limits, creating each principal's
+// token bucket and in-flight counter lazily on first use.
+type RateLimiter struct {
+	limits Limits
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	inFlight map[string]int
+}
+
+func NewRateLimiter(limits Limits) *RateLimiter {
+	return &RateLimiter{
+		limits:   limits,
+		limiters: make(map[string]*rate.Limiter),
+		inFlight: make(map[string]int),
+	}
+}
+
+func (rl *RateLimiter) limiterFor(principal string) *rate.Limiter {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	l, ok := rl.limiters[principal]
+	if !ok {
+		burst := rl.limits.Burst
+		if burst <= 0 {
+			// A zero burst would make rate.Limiter reject every request
+			// outright, regardless of RPS. Configuring an RPS without an
+			// explicit burst should mean "one request at a time", not
+			// "always reject".
+			burst = 1
+		}
+		l = rate.NewLimiter(rate.Limit(rl.limits.RPS), burst)
+		rl.limiters[principal] = l
+	}
+	return l
+}
+
+// Allow reports whether principal may proceed under the configured RPS, and
+// if so, reserves one of its concurrent in-flight slots - the caller must
+// call Release once the request finishes. Release must not be called when
+// Allow returned false, since it would free a slot held by another request.
+func (rl *RateLimiter) Allow(principal string) bool {
+	if rl.limits.RPS > 0 && !rl.limiterFor(principal).Allow() {
+		return false
+	}
+
+	if rl.limits.MaxInFlight <= 0 {
+		return true
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if rl.inFlight[principal] >= rl.limits.MaxInFlight {
+		return false
+	}
+	rl.inFlight[principal]++
+	return true
+}
+
+// Release frees the in-flight slot Allow reserved for principal.
+func (rl *RateLimiter) Release(principal string) {
+	if rl.limits.MaxInFlight <= 0 {
+		return
+	}
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if rl.inFlight[principal] > 0 {
+		rl.inFlight[principal]--
+	}
+}
@@ -0,0 +1,65 @@
+package auth
+
+import "testing"
+
+func TestRateLimiterAllowsWithinBurst(t *testing.T) {
+	rl := NewRateLimiter(Limits{RPS: 1, Burst: 2})
+
+	if !rl.Allow("alice") {
+		t.Error("Expected the first request within burst to be allowed")
+	}
+	if !rl.Allow("alice") {
+		t.Error("Expected the second request within burst to be allowed")
+	}
+	if rl.Allow("alice") {
+		t.Error("Expected a request beyond the burst to be rejected")
+	}
+}
+
+func TestRateLimiterTracksPrincipalsIndependently(t *testing.T) {
+	rl := NewRateLimiter(Limits{RPS: 1, Burst: 1})
+
+	if !rl.Allow("alice") {
+		t.Fatal("Expected alice's first request to be allowed")
+	}
+	if rl.Allow("alice") {
+		t.Error("Expected alice's second request to be rejected")
+	}
+	if !rl.Allow("bob") {
+		t.Error("Expected bob's request to be allowed independently of alice's limit")
+	}
+}
+
+func TestRateLimiterMaxInFlight(t *testing.T) {
+	rl := NewRateLimiter(Limits{MaxInFlight: 1})
+
+	if !rl.Allow("alice") {
+		t.Fatal("Expected the first in-flight request to be allowed")
+	}
+	if rl.Allow("alice") {
+		t.Error("Expected a second concurrent request to be rejected while the first is in flight")
+	}
+
+	rl.Release("alice")
+	if !rl.Allow("alice") {
+		t.Error("Expected a request to be allowed again after Release frees the in-flight slot")
+	}
+}
+
+func TestRateLimiterRPSWithoutExplicitBurstAllowsOneRequest(t *testing.T) {
+	rl := NewRateLimiter(Limits{RPS: 1})
+
+	if !rl.Allow("alice") {
+		t.Error("Expected a request to be allowed when RPS is set without an explicit Burst")
+	}
+}
+
+func TestRateLimiterUnboundedWhenLimitsAreZero(t *testing.T) {
+	rl := NewRateLimiter(Limits{})
+
+	for i := 0; i < 100; i++ {
+		if !rl.Allow("alice") {
+			t.Fatalf("Expected request %d to be allowed with zero-value Limits", i)
+		}
+	}
+}
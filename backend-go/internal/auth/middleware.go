@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware validates the Authorization: Bearer header against store, 401s
+// unauthenticated or unrecognized callers, 429s ones over their rate limit,
+// and tags the Gin context with the resolved principal under "principal" so
+// proxy.Handler can thread it into worker.AuditJob for audit scoping.
+func Middleware(store KeyStore, limiter *RateLimiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := BearerToken(c.GetHeader("Authorization"))
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing bearer token"})
+			return
+		}
+
+		principal, ok := store.Authenticate(token)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid bearer token"})
+			return
+		}
+
+		if limiter != nil {
+			if !limiter.Allow(principal) {
+				c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+				return
+			}
+			defer limiter.Release(principal)
+		}
+
+		c.Set("principal", principal)
+		c.Next()
+	}
+}
+
+// BearerOnly gates a route behind a single static bearer token, independent
+// of the per-key KeyStore/RateLimiter Middleware uses for /v1/*. It's meant
+// for /metrics, which operators may want gated without onboarding it as a
+// full tenant-scoped principal.
+func BearerOnly(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		supplied := BearerToken(c.GetHeader("Authorization"))
+		if subtle.ConstantTimeCompare([]byte(supplied), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid bearer token"})
+			return
+		}
+		c.Next()
+	}
+}
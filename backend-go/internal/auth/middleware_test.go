@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func newTestRouter(store KeyStore, limiter *RateLimiter) *gin.Engine {
+	router := gin.New()
+	router.Use(Middleware(store, limiter))
+	router.GET("/v1/models", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"principal": c.GetString("principal")})
+	})
+	return router
+}
+
+func TestMiddlewareRejectsMissingBearerToken(t *testing.T) {
+	router := newTestRouter(NewStaticKeyStore(nil), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/models", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401, got %d", rec.Code)
+	}
+}
+
+func TestMiddlewareRejectsUnknownKey(t *testing.T) {
+	router := newTestRouter(NewStaticKeyStore(map[string]string{"good-key": "alice"}), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/models", nil)
+	req.Header.Set("Authorization", "Bearer wrong-key")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401, got %d", rec.Code)
+	}
+}
+
+func TestMiddlewareTagsPrincipalAndAllowsValidKey(t *testing.T) {
+	router := newTestRouter(NewStaticKeyStore(map[string]string{"good-key": "alice"}), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/models", nil)
+	req.Header.Set("Authorization", "Bearer good-key")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `"principal":"alice"`) {
+		t.Errorf("Expected the response to echo the tagged principal, got %s", rec.Body.String())
+	}
+}
+
+func TestMiddlewareRejectsOverRateLimit(t *testing.T) {
+	limiter := NewRateLimiter(Limits{RPS: 1, Burst: 1})
+	router := newTestRouter(NewStaticKeyStore(map[string]string{"good-key": "alice"}), limiter)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/models", nil)
+	req.Header.Set("Authorization", "Bearer good-key")
+
+	rec1 := httptest.NewRecorder()
+	router.ServeHTTP(rec1, req)
+	if rec1.Code != http.StatusOK {
+		t.Fatalf("Expected the first request to succeed, got %d", rec1.Code)
+	}
+
+	rec2 := httptest.NewRecorder()
+	router.ServeHTTP(rec2, req)
+	if rec2.Code != http.StatusTooManyRequests {
+		t.Errorf("Expected 429 once the rate limit is exceeded, got %d", rec2.Code)
+	}
+}
+
+func TestBearerOnly(t *testing.T) {
+	router := gin.New()
+	router.GET("/metrics", BearerOnly("secret"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 without a token, got %d", rec.Code)
+	}
+
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected 200 with the correct token, got %d", rec.Code)
+	}
+}
+
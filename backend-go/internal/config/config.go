@@ -1,8 +1,10 @@
 package config
 
 import (
+	"net/netip"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -12,12 +14,80 @@ type Config struct {
 	WriteTimeout    time.Duration
 	ShutdownTimeout time.Duration
 	UpstreamURL     string
+	ChatProvider    string
 	GRPCAddress     string
 	GRPCTimeout     time.Duration
 	WSPort          int
 	WorkerCount     int
 	QueueSize       int
 	LogLevel        string
+
+	WSMaxMessageBytes        int
+	WSReadBufferSize         int
+	WSWriteBufferSize        int
+	WSChunkReassemblyTimeout time.Duration
+
+	JobStoreDir      string
+	SubmitTimeout    time.Duration
+	RetryMaxAttempts int
+	RetryBaseDelay   time.Duration
+	RetryMaxDelay    time.Duration
+
+	GRPCTLSEnabled            bool
+	GRPCTLSCAFile             string
+	GRPCTLSCertFile           string
+	GRPCTLSKeyFile            string
+	GRPCTLSServerNameOverride string
+	GRPCTLSInsecureSkipVerify bool
+	GRPCAuthToken             string
+
+	// TrustedProxies lists the CIDR ranges of reverse proxies (nginx, Caddy,
+	// Cloudflare, ...) allowed to set X-Forwarded-For / X-Real-IP. Empty by
+	// default, so those headers are ignored unless explicitly configured.
+	TrustedProxies []netip.Prefix
+
+	// AuditBackend selects how audit jobs are processed: "local" runs them
+	// in-process against the job store and worker pool (the default); "grpc"
+	// forwards them to a remote audit engine over a persistent gRPC stream
+	// instead, decoupling the proxy from needing its own durable queue.
+	AuditBackend          string
+	GRPCAuditBufferSize   int
+	GRPCAuditConcurrency  int
+	GRPCAuditBackpressure string
+
+	// TLSCertFile/TLSKeyFile configure static TLS termination on the HTTP and
+	// WebSocket listeners. Ignored when ACMEDomains is set.
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// ACMEDomains, when non-empty, provisions certs for those domains
+	// automatically via ACME (and takes over the static cert/key above).
+	// ACMEEmail registers the account that requests them.
+	ACMEDomains []string
+	ACMEEmail   string
+
+	// TrustProxyProtocol wraps the HTTP and WebSocket listeners with PROXY
+	// protocol decoding, so the true client address survives being fronted
+	// by an L4 load balancer and is what gets recorded in audit records.
+	TrustProxyProtocol bool
+
+	// AuthEnabled gates /v1/* behind Authorization: Bearer validation against
+	// AuthKeys. Disabled by default so existing deployments aren't locked out
+	// by upgrading.
+	AuthEnabled bool
+	// AuthKeys maps each accepted bearer token to the principal it
+	// authenticates as, tagged into worker.AuditJob for audit scoping.
+	AuthKeys map[string]string
+	// AuthRPS/AuthBurst/AuthMaxInFlight bound each principal's request rate
+	// and concurrency. A zero AuthRPS or AuthMaxInFlight leaves that bound
+	// unlimited; a zero AuthBurst with a nonzero AuthRPS means a burst of 1.
+	AuthRPS         float64
+	AuthBurst       int
+	AuthMaxInFlight int
+
+	// MetricsAuthToken, when set, gates /metrics behind a single static
+	// bearer token independent of AuthKeys. Empty leaves /metrics open.
+	MetricsAuthToken string
 }
 
 func Load() *Config {
@@ -27,12 +97,55 @@ func Load() *Config {
 		WriteTimeout:    getEnvDuration("TRUTHTABLE_WRITE_TIMEOUT", 120*time.Second),
 		ShutdownTimeout: getEnvDuration("TRUTHTABLE_SHUTDOWN_TIMEOUT", 10*time.Second),
 		UpstreamURL:     getEnv("TRUTHTABLE_UPSTREAM_URL", "https://api.openai.com"),
+		ChatProvider:    getEnv("TRUTHTABLE_CHAT_PROVIDER", "openai"),
 		GRPCAddress:     getEnv("TRUTHTABLE_GRPC_ADDRESS", "localhost:50051"),
 		GRPCTimeout:     getEnvDuration("TRUTHTABLE_GRPC_TIMEOUT", 30*time.Second),
 		WSPort:          getEnvInt("TRUTHTABLE_WS_PORT", 8081),
 		WorkerCount:     getEnvInt("TRUTHTABLE_WORKER_COUNT", 10),
 		QueueSize:       getEnvInt("TRUTHTABLE_QUEUE_SIZE", 1000),
 		LogLevel:        getEnv("TRUTHTABLE_LOG_LEVEL", "info"),
+
+		WSMaxMessageBytes:        getEnvInt("TRUTHTABLE_WS_MAX_MESSAGE_BYTES", 0),
+		WSReadBufferSize:         getEnvInt("TRUTHTABLE_WS_READ_BUFFER_SIZE", 1024),
+		WSWriteBufferSize:        getEnvInt("TRUTHTABLE_WS_WRITE_BUFFER_SIZE", 1024),
+		WSChunkReassemblyTimeout: getEnvDuration("TRUTHTABLE_WS_CHUNK_REASSEMBLY_TIMEOUT", 5*time.Second),
+
+		JobStoreDir:      getEnv("TRUTHTABLE_JOB_STORE_DIR", "./data/jobs"),
+		SubmitTimeout:    getEnvDuration("TRUTHTABLE_SUBMIT_TIMEOUT", 50*time.Millisecond),
+		RetryMaxAttempts: getEnvInt("TRUTHTABLE_RETRY_MAX_ATTEMPTS", 5),
+		RetryBaseDelay:   getEnvDuration("TRUTHTABLE_RETRY_BASE_DELAY", 500*time.Millisecond),
+		RetryMaxDelay:    getEnvDuration("TRUTHTABLE_RETRY_MAX_DELAY", 30*time.Second),
+
+		GRPCTLSEnabled:            getEnvBool("TRUTHTABLE_GRPC_TLS_ENABLED", false),
+		GRPCTLSCAFile:             getEnv("TRUTHTABLE_GRPC_TLS_CA_FILE", ""),
+		GRPCTLSCertFile:           getEnv("TRUTHTABLE_GRPC_TLS_CERT_FILE", ""),
+		GRPCTLSKeyFile:            getEnv("TRUTHTABLE_GRPC_TLS_KEY_FILE", ""),
+		GRPCTLSServerNameOverride: getEnv("TRUTHTABLE_GRPC_TLS_SERVER_NAME", ""),
+		GRPCTLSInsecureSkipVerify: getEnvBool("TRUTHTABLE_GRPC_TLS_INSECURE_SKIP_VERIFY", false),
+		GRPCAuthToken:             getEnv("TRUTHTABLE_GRPC_AUTH_TOKEN", ""),
+
+		TrustedProxies: getEnvPrefixList("TRUTHTABLE_TRUSTED_PROXIES"),
+
+		AuditBackend:          getEnv("TRUTHTABLE_AUDIT_BACKEND", "local"),
+		GRPCAuditBufferSize:   getEnvInt("TRUTHTABLE_GRPC_AUDIT_BUFFER_SIZE", 1000),
+		GRPCAuditConcurrency:  getEnvInt("TRUTHTABLE_GRPC_AUDIT_CONCURRENCY", 10),
+		GRPCAuditBackpressure: getEnv("TRUTHTABLE_GRPC_AUDIT_BACKPRESSURE", "block"),
+
+		TLSCertFile: getEnv("TRUTHTABLE_TLS_CERT_FILE", ""),
+		TLSKeyFile:  getEnv("TRUTHTABLE_TLS_KEY_FILE", ""),
+
+		ACMEDomains: getEnvStringList("TRUTHTABLE_ACME_DOMAINS"),
+		ACMEEmail:   getEnv("TRUTHTABLE_ACME_EMAIL", ""),
+
+		TrustProxyProtocol: getEnvBool("TRUTHTABLE_TRUST_PROXY_PROTOCOL", false),
+
+		AuthEnabled:     getEnvBool("TRUTHTABLE_AUTH_ENABLED", false),
+		AuthKeys:        getEnvKeyValueMap("TRUTHTABLE_AUTH_KEYS"),
+		AuthRPS:         getEnvFloat("TRUTHTABLE_AUTH_RPS", 0),
+		AuthBurst:       getEnvInt("TRUTHTABLE_AUTH_BURST", 0),
+		AuthMaxInFlight: getEnvInt("TRUTHTABLE_AUTH_MAX_IN_FLIGHT", 0),
+
+		MetricsAuthToken: getEnv("TRUTHTABLE_METRICS_AUTH_TOKEN", ""),
 	}
 }
 
@@ -52,6 +165,91 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvPrefixList parses a comma-separated list of CIDRs (e.g.
+// "10.0.0.0/8,192.168.1.0/24"). Entries that fail to parse are skipped.
+func getEnvPrefixList(key string) []netip.Prefix {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	var prefixes []netip.Prefix
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes
+}
+
+// getEnvStringList parses a comma-separated list (e.g. "a.example.com,
+// b.example.com"). Entries are trimmed and empty ones are skipped.
+func getEnvStringList(key string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// getEnvKeyValueMap parses a comma-separated list of key:value pairs (e.g.
+// "sk-abc:alice,sk-def:bob") into a map. Entries that are malformed (no ':')
+// or have an empty key or value are skipped.
+func getEnvKeyValueMap(key string) map[string]string {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	pairs := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		k, v, found := strings.Cut(entry, ":")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !found || k == "" || v == "" {
+			continue
+		}
+		pairs[k] = v
+	}
+	return pairs
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
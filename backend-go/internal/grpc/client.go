@@ -6,7 +6,9 @@ import (
 	"time"
 
 	pb "github.com/truthtable/backend-go/api/audit/v1"
+	"github.com/truthtable/backend-go/internal/metrics"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -16,6 +18,39 @@ type AuditClient struct {
 	timeout time.Duration
 }
 
+// ClientOption configures optional transport and auth behavior on NewAuditClient.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	tlsConfig   *TLSConfig
+	perRPCCreds credentials.PerRPCCredentials
+	skipPing    bool
+}
+
+// WithTLS enables TLS (or mTLS, if CertFile/KeyFile are set) using cfg
+// instead of the default insecure transport.
+func WithTLS(cfg TLSConfig) ClientOption {
+	return func(o *clientOptions) { o.tlsConfig = &cfg }
+}
+
+// WithStaticToken attaches token as a bearer token on every RPC.
+// requireTransportTLS should only be false for trusted, non-TLS networks.
+func WithStaticToken(token string, requireTransportTLS bool) ClientOption {
+	return func(o *clientOptions) { o.perRPCCreds = NewStaticTokenCredentials(token, requireTransportTLS) }
+}
+
+// WithPerRPCCredentials attaches an arbitrary credentials.PerRPCCredentials,
+// e.g. one that refreshes a token from an external source.
+func WithPerRPCCredentials(creds credentials.PerRPCCredentials) ClientOption {
+	return func(o *clientOptions) { o.perRPCCreds = creds }
+}
+
+// withoutStartupPing skips the Ping-based startup probe. Used by tests that
+// construct a client without a reachable audit engine.
+func withoutStartupPing() ClientOption {
+	return func(o *clientOptions) { o.skipPing = true }
+}
+
 type ClaimResult struct {
 	Text       string
 	Verdict    string
@@ -28,62 +63,144 @@ type AuditResult struct {
 	Summary    string
 }
 
-func NewAuditClient(address string, timeout time.Duration) (*AuditClient, error) {
+// NewAuditClient dials the audit engine at address. By default it uses an
+// insecure transport; pass WithTLS to require TLS or mTLS, and WithStaticToken
+// / WithPerRPCCredentials to authenticate each RPC. Unless the startup probe
+// is disabled, it fails fast with a clear error when the TLS handshake or
+// auth token is rejected, rather than deferring the failure to the first
+// Evaluate call.
+func NewAuditClient(address string, timeout time.Duration, opts ...ClientOption) (*AuditClient, error) {
+	options := clientOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	transportCreds := insecure.NewCredentials()
+	if options.tlsConfig != nil {
+		creds, err := options.tlsConfig.credentials()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build TLS credentials for %s: %w", address, err)
+		}
+		transportCreds = creds
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(transportCreds)}
+	if options.perRPCCreds != nil {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(options.perRPCCreds))
+	}
+
 	// Non-blocking dial - connection happens lazily on first RPC
-	conn, err := grpc.Dial(address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := grpc.Dial(address, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gRPC client for %s: %w", address, err)
 	}
 
-	return &AuditClient{
+	client := &AuditClient{
 		conn:    conn,
 		client:  pb.NewAuditServiceClient(conn),
 		timeout: timeout,
-	}, nil
-}
-
-func (c *AuditClient) Evaluate(ctx context.Context, requestID, prompt, response string) (*AuditResult, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
-	defer cancel()
-
-	req := &pb.AuditRequest{
-		RequestId: requestID,
-		Query:     prompt,
-		Response:  response,
 	}
 
-	submission, err := c.client.SubmitAudit(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("audit submission failed: %w", err)
+	if !options.skipPing {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := client.Ping(ctx); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("startup probe failed for %s: %w", address, err)
+		}
 	}
 
-	resultReq := &pb.AuditResultRequest{
-		AuditId: submission.AuditId,
-	}
+	return client, nil
+}
+
+// EvaluateStream submits an audit and relays every incremental result the audit
+// engine reports over the AuditResultUpdate stream until the audit reaches a
+// terminal status. The returned channels are both closed once the stream ends;
+// at most one value is ever sent on errCh.
+func (c *AuditClient) EvaluateStream(ctx context.Context, requestID, prompt, response string) (<-chan *AuditResult, <-chan error) {
+	resultCh := make(chan *AuditResult)
+	errCh := make(chan error, 1)
+
+	go func() {
+		defer close(resultCh)
+		defer close(errCh)
+
+		start := time.Now()
+		var callErr error
+		defer func() { metrics.RecordAuditCall(time.Since(start), callErr) }()
+
+		ctx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+
+		req := &pb.AuditRequest{
+			RequestId: requestID,
+			Query:     prompt,
+			Response:  response,
+		}
 
-	var auditResult *pb.AuditResult
-	for i := 0; i < 30; i++ {
-		auditResult, err = c.client.GetAuditResult(ctx, resultReq)
+		submission, err := c.client.SubmitAudit(ctx, req)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get audit result: %w", err)
+			callErr = fmt.Errorf("audit submission failed: %w", err)
+			errCh <- callErr
+			return
 		}
-		if auditResult.Status == pb.AuditStatus_AUDIT_STATUS_COMPLETED {
-			break
+
+		stream, err := c.client.StreamAuditResult(ctx, &pb.AuditResultRequest{AuditId: submission.AuditId})
+		if err != nil {
+			callErr = fmt.Errorf("failed to open audit result stream: %w", err)
+			errCh <- callErr
+			return
 		}
-		if auditResult.Status == pb.AuditStatus_AUDIT_STATUS_FAILED {
-			return nil, fmt.Errorf("audit failed")
+
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				callErr = fmt.Errorf("audit result stream closed unexpectedly: %w", err)
+				errCh <- callErr
+				return
+			}
+
+			result := convertAuditResult(update)
+
+			switch update.Status {
+			case pb.AuditStatus_AUDIT_STATUS_FAILED:
+				callErr = fmt.Errorf("audit failed")
+				errCh <- callErr
+				return
+			case pb.AuditStatus_AUDIT_STATUS_COMPLETED:
+				resultCh <- result
+				return
+			default:
+				resultCh <- result
+			}
 		}
-		time.Sleep(100 * time.Millisecond)
-	}
+	}()
+
+	return resultCh, errCh
+}
+
+// Evaluate submits an audit and blocks until the terminal result is available.
+// It is a thin wrapper over EvaluateStream kept for callers that only care
+// about the final verdict.
+func (c *AuditClient) Evaluate(ctx context.Context, requestID, prompt, response string) (*AuditResult, error) {
+	resultCh, errCh := c.EvaluateStream(ctx, requestID, prompt, response)
 
-	if auditResult == nil || auditResult.Status != pb.AuditStatus_AUDIT_STATUS_COMPLETED {
+	var last *AuditResult
+	for result := range resultCh {
+		last = result
+	}
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+	if last == nil {
 		return nil, fmt.Errorf("audit timed out")
 	}
+	return last, nil
+}
 
-	claims := make([]*ClaimResult, len(auditResult.Claims))
-	for i, claim := range auditResult.Claims {
+func convertAuditResult(update *pb.AuditResultUpdate) *AuditResult {
+	claims := make([]*ClaimResult, len(update.Claims))
+	for i, claim := range update.Claims {
 		claims[i] = &ClaimResult{
 			Text:       claim.Claim,
 			Verdict:    claim.Status.String(),
@@ -92,10 +209,10 @@ func (c *AuditClient) Evaluate(ctx context.Context, requestID, prompt, response
 	}
 
 	return &AuditResult{
-		TrustScore: float64(auditResult.FaithfulnessScore),
+		TrustScore: float64(update.FaithfulnessScore),
 		Claims:     claims,
-		Summary:    auditResult.ReasoningTrace,
-	}, nil
+		Summary:    update.ReasoningTrace,
+	}
 }
 
 func (c *AuditClient) Close() error {
@@ -0,0 +1,306 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	pb "github.com/truthtable/backend-go/api/audit/v1"
+)
+
+// StreamBackoff controls the delay between reconnect attempts when a
+// StreamSession's underlying Stream RPC drops.
+type StreamBackoff struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// DefaultStreamBackoff is used when a StreamSession is opened with a
+// zero-valued StreamBackoff.
+var DefaultStreamBackoff = StreamBackoff{
+	BaseDelay: 500 * time.Millisecond,
+	MaxDelay:  30 * time.Second,
+}
+
+// nextDelay returns an exponential backoff with full jitter for the given
+// attempt (1-indexed), mirroring worker.RetryPolicy.nextDelay.
+func (b StreamBackoff) nextDelay(attempt int) time.Duration {
+	baseDelay, maxDelay := b.BaseDelay, b.MaxDelay
+	if baseDelay <= 0 {
+		baseDelay = DefaultStreamBackoff.BaseDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultStreamBackoff.MaxDelay
+	}
+	delay := baseDelay << uint(attempt-1)
+	if delay <= 0 || delay > maxDelay {
+		delay = maxDelay
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// streamUpdateBuffer bounds how many AuditResult updates dispatch can queue
+// for a single job before it blocks. It decouples the shared serve() recv
+// loop from each job's own consumer, so a slow or stuck consumer for one job
+// only backs up that job's buffer instead of stalling delivery for every
+// other job multiplexed on the same stream.
+const streamUpdateBuffer = 32
+
+type pendingJob struct {
+	updates chan *pb.AuditResult
+	abort   chan error
+}
+
+// StreamSession is a persistent, auto-reconnecting bidirectional Stream RPC
+// to the audit engine. Unlike EvaluateStream, which opens a fresh
+// SubmitAudit/StreamAuditResult pair per job, a StreamSession multiplexes
+// every submitted job and its results over one long-lived connection,
+// buffering outbound jobs and reconnecting with exponential backoff
+// whenever the stream drops. Results are correlated back to callers by
+// RequestID, since multiple jobs can be in flight on the stream at once.
+type StreamSession struct {
+	client  *AuditClient
+	backoff StreamBackoff
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	outbound chan *pb.AuditJob
+
+	mu      sync.Mutex
+	pending map[string]*pendingJob
+}
+
+// NewStreamSession prepares a StreamSession against client. Submit can be
+// called before Start; jobs are buffered until the stream connects.
+func NewStreamSession(client *AuditClient, backoff StreamBackoff) *StreamSession {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &StreamSession{
+		client:   client,
+		backoff:  backoff,
+		ctx:      ctx,
+		cancel:   cancel,
+		outbound: make(chan *pb.AuditJob, 1000),
+		pending:  make(map[string]*pendingJob),
+	}
+}
+
+// Start launches the background goroutine that keeps the stream connected.
+func (s *StreamSession) Start() {
+	s.wg.Add(1)
+	go s.run()
+}
+
+// Stop cancels the session and waits for the background goroutine to exit,
+// failing any jobs still awaiting a terminal result.
+func (s *StreamSession) Stop() {
+	s.cancel()
+	s.wg.Wait()
+	s.failAllPending(fmt.Errorf("audit stream session stopped"))
+}
+
+// Submit enqueues an audit job onto the persistent stream and returns
+// channels that receive every incremental AuditResult and, at most once, a
+// terminal error - the same contract as AuditClient.EvaluateStream, so
+// GRPCPool can use either transport interchangeably. Submit does not block
+// on the stream being connected; a job submitted while reconnecting is
+// buffered and sent once the stream comes back up.
+func (s *StreamSession) Submit(requestID, prompt, response string) (<-chan *AuditResult, <-chan error) {
+	resultCh := make(chan *AuditResult)
+	errCh := make(chan error, 1)
+
+	job := &pendingJob{
+		updates: make(chan *pb.AuditResult, streamUpdateBuffer),
+		abort:   make(chan error, 1),
+	}
+
+	s.mu.Lock()
+	s.pending[requestID] = job
+	s.mu.Unlock()
+
+	go s.forward(job, resultCh, errCh)
+
+	select {
+	case s.outbound <- &pb.AuditJob{RequestId: requestID, Query: prompt, Response: response}:
+	case <-s.ctx.Done():
+		s.abortPending(requestID, fmt.Errorf("audit stream session stopped"))
+	}
+
+	return resultCh, errCh
+}
+
+// forward owns requestID's public resultCh/errCh and drains job.updates
+// (fed by dispatch) and job.abort (fed by abortPending/failAllPending) onto
+// them. Running this per job, rather than sending on resultCh directly from
+// the shared serve() recv loop, means a consumer that stops draining
+// resultCh only fills that job's own buffered updates channel instead of
+// blocking dispatch for every other job on the stream.
+func (s *StreamSession) forward(job *pendingJob, resultCh chan<- *AuditResult, errCh chan<- error) {
+	defer close(resultCh)
+	defer close(errCh)
+
+	for {
+		select {
+		case update := <-job.updates:
+			if update.Status == pb.AuditStatus_AUDIT_STATUS_FAILED {
+				errCh <- fmt.Errorf("audit failed")
+				return
+			}
+			resultCh <- convertStreamResult(update)
+			if update.Status == pb.AuditStatus_AUDIT_STATUS_COMPLETED {
+				return
+			}
+		case err := <-job.abort:
+			errCh <- err
+			return
+		}
+	}
+}
+
+// run keeps a Stream RPC connected for the life of the session. Backoff is
+// applied after every disconnect, whether the failure is an initial connect
+// error or a stream that broke mid-serve, so a reconnect never fires with
+// zero delay against an audit engine that is down or resetting connections.
+func (s *StreamSession) run() {
+	defer s.wg.Done()
+
+	for attempt := 1; s.ctx.Err() == nil; attempt++ {
+		stream, err := s.client.client.Stream(s.ctx)
+		if err != nil {
+			log.Printf("audit stream connect failed (attempt %d): %v", attempt, err)
+		} else {
+			log.Printf("audit stream connected")
+			s.serve(stream)
+			if s.ctx.Err() != nil {
+				return
+			}
+		}
+
+		if !s.sleep(attempt) {
+			return
+		}
+	}
+}
+
+// serve sends buffered jobs and dispatches results on stream until it
+// breaks, then returns so run can reconnect.
+func (s *StreamSession) serve(stream pb.AuditService_StreamClient) {
+	sendDone := make(chan struct{})
+
+	go func() {
+		defer close(sendDone)
+		for {
+			select {
+			case job := <-s.outbound:
+				if err := stream.Send(job); err != nil {
+					log.Printf("[%s] audit stream send failed, will retry on reconnect: %v", job.RequestId, err)
+					s.requeue(job)
+					return
+				}
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		result, err := stream.Recv()
+		if err != nil {
+			<-sendDone
+			if s.ctx.Err() == nil {
+				log.Printf("audit stream disconnected, will reconnect: %v", err)
+				s.failAllPending(err)
+			}
+			return
+		}
+		s.dispatch(result)
+	}
+}
+
+// requeue puts job back on the outbound buffer so it is resent once a new
+// stream connects, since serve's sender goroutine has already exited.
+func (s *StreamSession) requeue(job *pb.AuditJob) {
+	go func() {
+		select {
+		case s.outbound <- job:
+		case <-s.ctx.Done():
+		}
+	}()
+}
+
+func (s *StreamSession) sleep(attempt int) bool {
+	select {
+	case <-time.After(s.backoff.nextDelay(attempt)):
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+func (s *StreamSession) dispatch(update *pb.AuditResult) {
+	terminal := update.Status == pb.AuditStatus_AUDIT_STATUS_COMPLETED || update.Status == pb.AuditStatus_AUDIT_STATUS_FAILED
+
+	s.mu.Lock()
+	p, ok := s.pending[update.RequestId]
+	if ok && terminal {
+		delete(s.pending, update.RequestId)
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		log.Printf("[%s] audit stream result for unknown or already-finished job, dropping", update.RequestId)
+		return
+	}
+
+	p.updates <- update
+}
+
+// abortPending fails requestID's job with err, for use when it never makes
+// it onto the stream at all (e.g. the session stopped before Submit could
+// enqueue it).
+func (s *StreamSession) abortPending(requestID string, err error) {
+	s.mu.Lock()
+	p, ok := s.pending[requestID]
+	if ok {
+		delete(s.pending, requestID)
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return
+	}
+	p.abort <- err
+}
+
+func (s *StreamSession) failAllPending(err error) {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[string]*pendingJob)
+	s.mu.Unlock()
+
+	for requestID, p := range pending {
+		log.Printf("[%s] audit stream broke before a terminal result arrived", requestID)
+		p.abort <- err
+	}
+}
+
+func convertStreamResult(update *pb.AuditResult) *AuditResult {
+	claims := make([]*ClaimResult, len(update.Claims))
+	for i, claim := range update.Claims {
+		claims[i] = &ClaimResult{
+			Text:       claim.Claim,
+			Verdict:    claim.Status.String(),
+			Confidence: float64(claim.Confidence),
+		}
+	}
+
+	return &AuditResult{
+		TrustScore: float64(update.FaithfulnessScore),
+		Claims:     claims,
+		Summary:    update.ReasoningTrace,
+	}
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/truthtable/backend-go/api/audit/v1"
+)
+
+var errTest = errors.New("test error")
+
+func TestStreamSessionDispatchDeliversIncrementalResults(t *testing.T) {
+	s := NewStreamSession(nil, StreamBackoff{})
+	resultCh, errCh := s.Submit("req-1", "query", "response")
+
+	go s.dispatch(&pb.AuditResult{RequestId: "req-1", Status: pb.AuditStatus_AUDIT_STATUS_IN_PROGRESS, FaithfulnessScore: 0.5})
+	if got := <-resultCh; got.TrustScore != 0.5 {
+		t.Errorf("Expected in-progress TrustScore 0.5, got %v", got.TrustScore)
+	}
+
+	go s.dispatch(&pb.AuditResult{RequestId: "req-1", Status: pb.AuditStatus_AUDIT_STATUS_COMPLETED, FaithfulnessScore: 0.9})
+	final, ok := <-resultCh
+	if !ok || float32(final.TrustScore) != float32(0.9) {
+		t.Fatalf("Expected completed TrustScore 0.9, got %v (ok=%v)", final, ok)
+	}
+
+	if _, ok := <-resultCh; ok {
+		t.Error("Expected resultCh to be closed after a terminal result")
+	}
+	if err := <-errCh; err != nil {
+		t.Errorf("Expected nil error on successful completion, got %v", err)
+	}
+}
+
+func TestStreamSessionDispatchSurfacesFailedStatusAsError(t *testing.T) {
+	s := NewStreamSession(nil, StreamBackoff{})
+	resultCh, errCh := s.Submit("req-1", "query", "response")
+
+	s.dispatch(&pb.AuditResult{RequestId: "req-1", Status: pb.AuditStatus_AUDIT_STATUS_FAILED})
+
+	if _, ok := <-resultCh; ok {
+		t.Error("Expected resultCh to be closed on failure")
+	}
+	if err := <-errCh; err == nil {
+		t.Error("Expected a non-nil error for a failed audit")
+	}
+}
+
+func TestStreamSessionDispatchIgnoresUnknownRequestID(t *testing.T) {
+	s := NewStreamSession(nil, StreamBackoff{})
+
+	// Should not block or panic even though no job with this RequestID was submitted.
+	s.dispatch(&pb.AuditResult{RequestId: "unknown", Status: pb.AuditStatus_AUDIT_STATUS_COMPLETED})
+}
+
+func TestStreamSessionDispatchIsolatesStuckConsumer(t *testing.T) {
+	s := NewStreamSession(nil, StreamBackoff{})
+	_, _ = s.Submit("req-stuck", "q", "r") // resultCh deliberately never read
+
+	done := make(chan struct{})
+	go func() {
+		s.dispatch(&pb.AuditResult{RequestId: "req-stuck", Status: pb.AuditStatus_AUDIT_STATUS_IN_PROGRESS, FaithfulnessScore: 0.1})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("dispatch blocked delivering to a stuck consumer instead of only buffering for that job")
+	}
+
+	resultCh2, _ := s.Submit("req-2", "q2", "r2")
+	go s.dispatch(&pb.AuditResult{RequestId: "req-2", Status: pb.AuditStatus_AUDIT_STATUS_COMPLETED, FaithfulnessScore: 0.7})
+	select {
+	case got, ok := <-resultCh2:
+		if !ok || float32(got.TrustScore) != float32(0.7) {
+			t.Errorf("Expected TrustScore 0.7 for req-2, got %v (ok=%v)", got, ok)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("req-2's result was blocked by req-stuck's stuck consumer")
+	}
+}
+
+func TestStreamSessionFailAllPendingFailsEveryJob(t *testing.T) {
+	s := NewStreamSession(nil, StreamBackoff{})
+	resultCh1, errCh1 := s.Submit("req-1", "q1", "r1")
+	resultCh2, errCh2 := s.Submit("req-2", "q2", "r2")
+
+	s.failAllPending(errTest)
+
+	for _, ch := range []<-chan *AuditResult{resultCh1, resultCh2} {
+		if _, ok := <-ch; ok {
+			t.Error("Expected resultCh to be closed after failAllPending")
+		}
+	}
+	if err := <-errCh1; err != errTest {
+		t.Errorf("Expected errTest, got %v", err)
+	}
+	if err := <-errCh2; err != errTest {
+		t.Errorf("Expected errTest, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package grpc
+
+import "testing"
+
+func TestTLSConfigCredentialsRequiresValidCAFile(t *testing.T) {
+	cfg := TLSConfig{CAFile: "/nonexistent/ca.pem"}
+
+	if _, err := cfg.credentials(); err == nil {
+		t.Fatal("Expected an error for a missing CA file, got nil")
+	}
+}
+
+func TestTLSConfigCredentialsInsecureSkipVerify(t *testing.T) {
+	cfg := TLSConfig{InsecureSkipVerify: true}
+
+	creds, err := cfg.credentials()
+	if err != nil {
+		t.Fatalf("credentials() failed: %v", err)
+	}
+	if creds == nil {
+		t.Fatal("Expected non-nil transport credentials")
+	}
+}
+
+func TestStaticTokenCredentialsMetadata(t *testing.T) {
+	creds := NewStaticTokenCredentials("secret-token", true)
+
+	md, err := creds.GetRequestMetadata(nil)
+	if err != nil {
+		t.Fatalf("GetRequestMetadata failed: %v", err)
+	}
+	if got, want := md["authorization"], "Bearer secret-token"; got != want {
+		t.Errorf("Expected authorization %q, got %q", want, got)
+	}
+	if !creds.RequireTransportSecurity() {
+		t.Error("Expected RequireTransportSecurity to be true")
+	}
+}
+
+func TestStaticTokenCredentialsAllowsInsecureTransport(t *testing.T) {
+	creds := NewStaticTokenCredentials("secret-token", false)
+
+	if creds.RequireTransportSecurity() {
+		t.Error("Expected RequireTransportSecurity to be false")
+	}
+}
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BackendSnapshot mirrors worker.Metrics. It's redeclared here rather than
+// imported so this package doesn't depend on internal/worker, which (via
+// internal/grpc and internal/websocket) already depends on internal/metrics.
+type BackendSnapshot struct {
+	QueueDepth      int
+	InFlight        int
+	RetryCount      int64
+	DeadLetterCount int64
+	DroppedCount    int64
+}
+
+// backendCollector adapts a worker.AuditBackend's point-in-time Metrics()
+// snapshot into Prometheus metrics, polled fresh on every scrape via snapshot
+// rather than pushed, since AuditBackend already exposes a cheap snapshot.
+type backendCollector struct {
+	snapshot func() BackendSnapshot
+
+	queueDepth   *prometheus.Desc
+	inFlight     *prometheus.Desc
+	retryTotal   *prometheus.Desc
+	deadLetter   *prometheus.Desc
+	droppedTotal *prometheus.Desc
+}
+
+func newBackendCollector(name string, snapshot func() BackendSnapshot) *backendCollector {
+	constLabels := prometheus.Labels{"backend": name}
+	return &backendCollector{
+		snapshot: snapshot,
+		queueDepth: prometheus.NewDesc(
+			"truthtable_worker_queue_depth",
+			"Number of audit jobs currently buffered.",
+			nil, constLabels),
+		inFlight: prometheus.NewDesc(
+			"truthtable_worker_in_flight",
+			"Number of audit jobs currently being processed.",
+			nil, constLabels),
+		retryTotal: prometheus.NewDesc(
+			"truthtable_worker_retries_total",
+			"Total audit job retries.",
+			nil, constLabels),
+		deadLetter: prometheus.NewDesc(
+			"truthtable_worker_dead_lettered_total",
+			"Total audit jobs dead-lettered after exhausting retries.",
+			nil, constLabels),
+		droppedTotal: prometheus.NewDesc(
+			"truthtable_worker_dropped_total",
+			"Total audit jobs dropped because the backend was full.",
+			nil, constLabels),
+	}
+}
+
+func (c *backendCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.queueDepth
+	ch <- c.inFlight
+	ch <- c.retryTotal
+	ch <- c.deadLetter
+	ch <- c.droppedTotal
+}
+
+func (c *backendCollector) Collect(ch chan<- prometheus.Metric) {
+	m := c.snapshot()
+	ch <- prometheus.MustNewConstMetric(c.queueDepth, prometheus.GaugeValue, float64(m.QueueDepth))
+	ch <- prometheus.MustNewConstMetric(c.inFlight, prometheus.GaugeValue, float64(m.InFlight))
+	ch <- prometheus.MustNewConstMetric(c.retryTotal, prometheus.CounterValue, float64(m.RetryCount))
+	ch <- prometheus.MustNewConstMetric(c.deadLetter, prometheus.CounterValue, float64(m.DeadLetterCount))
+	ch <- prometheus.MustNewConstMetric(c.droppedTotal, prometheus.CounterValue, float64(m.DroppedCount))
+}
+
+var (
+	backendCollectorMu sync.Mutex
+	registeredBackend  prometheus.Collector
+)
+
+// RegisterAuditBackend wires an AuditBackend's queue depth, in-flight,
+// retry, dead-letter, and dropped-job counts into the /metrics exposition
+// under name (e.g. "local" or "grpc", matching TRUTHTABLE_AUDIT_BACKEND).
+// snapshot is called fresh on every scrape. Call once at startup after
+// constructing the backend, and again after a hot reload rebuilds it - a
+// prior registration is unregistered first so the swap doesn't panic.
+func RegisterAuditBackend(name string, snapshot func() BackendSnapshot) {
+	backendCollectorMu.Lock()
+	defer backendCollectorMu.Unlock()
+
+	if registeredBackend != nil {
+		prometheus.Unregister(registeredBackend)
+	}
+	c := newBackendCollector(name, snapshot)
+	prometheus.MustRegister(c)
+	registeredBackend = c
+}
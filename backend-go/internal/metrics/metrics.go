@@ -0,0 +1,79 @@
+// Package metrics exposes the proxy's Prometheus instrumentation. Collectors
+// are package-level so any internal package can record against them without
+// threading a registry through constructors; RegisterAuditBackend is the one
+// exception, since it needs a reference to the active worker.AuditBackend.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "truthtable",
+		Subsystem: "proxy",
+		Name:      "http_requests_total",
+		Help:      "Total HTTP requests handled by the proxy, labelled by method, route, status, and upstream model.",
+	}, []string{"method", "path", "status", "model"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "truthtable",
+		Subsystem: "proxy",
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency in seconds, labelled by method, route, and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "path", "status"})
+
+	auditCallDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "truthtable",
+		Subsystem: "audit",
+		Name:      "grpc_call_duration_seconds",
+		Help:      "Latency of one AuditClient.EvaluateStream call to the audit engine, in seconds.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	auditCallErrorsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "truthtable",
+		Subsystem: "audit",
+		Name:      "grpc_call_errors_total",
+		Help:      "Total AuditClient.EvaluateStream calls that ended in an error.",
+	})
+
+	wsConnectedClients = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "truthtable",
+		Subsystem: "websocket",
+		Name:      "connected_clients",
+		Help:      "Number of WebSocket dashboard clients currently connected.",
+	})
+)
+
+// ObserveHTTPRequest records one completed request for the /metrics
+// exposition. model is empty for routes that don't front an LLM call
+// (health checks, passthrough endpoints).
+func ObserveHTTPRequest(method, path, status, model string, duration time.Duration) {
+	httpRequestsTotal.WithLabelValues(method, path, status, model).Inc()
+	httpRequestDuration.WithLabelValues(method, path, status).Observe(duration.Seconds())
+}
+
+// RecordAuditCall records the outcome of one AuditClient.EvaluateStream call.
+func RecordAuditCall(duration time.Duration, err error) {
+	auditCallDuration.Observe(duration.Seconds())
+	if err != nil {
+		auditCallErrorsTotal.Inc()
+	}
+}
+
+// SetWSConnectedClients reports the Hub's current connected-client count.
+func SetWSConnectedClients(n int) {
+	wsConnectedClients.Set(float64(n))
+}
+
+// Handler returns the http.Handler that serves the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,72 @@
+package proxy
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ClientIP resolves the originating client address for r. X-Forwarded-For
+// and X-Real-IP are only trusted to the extent the request arrived through a
+// hop inside trusted - if the immediate peer (RemoteAddr) isn't trusted,
+// both headers are ignored, since an untrusted client could set them to
+// anything.
+//
+// X-Forwarded-For is walked right-to-left (nearest hop first), skipping
+// entries inside a trusted prefix and entries that don't parse as an
+// address, until the first untrusted entry - that's the real client. If every entry is trusted, or the header
+// is absent, X-Real-IP is used when present, and RemoteAddr is the final
+// fallback.
+func ClientIP(r *http.Request, trusted []netip.Prefix) netip.Addr {
+	peer := remoteAddr(r)
+
+	if !isTrustedAddr(peer, trusted) {
+		return peer
+	}
+
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				continue
+			}
+			if !isTrustedAddr(addr, trusted) {
+				return addr
+			}
+		}
+	}
+
+	if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+		if addr, err := netip.ParseAddr(strings.TrimSpace(realIP)); err == nil {
+			return addr
+		}
+	}
+
+	return peer
+}
+
+// remoteAddr parses r.RemoteAddr into a netip.Addr, stripping the port if
+// present. It returns the zero Addr (IsValid() == false) if RemoteAddr is
+// empty or malformed.
+func remoteAddr(r *http.Request) netip.Addr {
+	host := r.RemoteAddr
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	addr, _ := netip.ParseAddr(host)
+	return addr
+}
+
+func isTrustedAddr(addr netip.Addr, trusted []netip.Prefix) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	for _, prefix := range trusted {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
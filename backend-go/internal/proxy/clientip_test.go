@@ -0,0 +1,85 @@
+package proxy
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func mustPrefix(t *testing.T, s string) netip.Prefix {
+	t.Helper()
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		t.Fatalf("failed to parse prefix %q: %v", s, err)
+	}
+	return p
+}
+
+func TestClientIPUsesRemoteAddrWhenNoProxiesTrusted(t *testing.T) {
+	r := httptest.NewRequest("POST", "/v1/chat/completions", nil)
+	r.RemoteAddr = "203.0.113.5:54321"
+	r.Header.Set("X-Forwarded-For", "198.51.100.9")
+
+	got := ClientIP(r, nil)
+
+	if got.String() != "203.0.113.5" {
+		t.Errorf("Expected RemoteAddr 203.0.113.5, got %q", got.String())
+	}
+}
+
+func TestClientIPIgnoresSpoofedXFFFromUntrustedPeer(t *testing.T) {
+	trusted := []netip.Prefix{mustPrefix(t, "10.0.0.0/8")}
+
+	r := httptest.NewRequest("POST", "/v1/chat/completions", nil)
+	r.RemoteAddr = "203.0.113.5:54321" // not in the trusted range
+	r.Header.Set("X-Forwarded-For", "1.2.3.4")
+	r.Header.Set("X-Real-IP", "1.2.3.4")
+
+	got := ClientIP(r, trusted)
+
+	if got.String() != "203.0.113.5" {
+		t.Errorf("Expected spoofed headers to be ignored, got %q", got.String())
+	}
+}
+
+func TestClientIPWalksXFFRightToLeftSkippingTrustedHops(t *testing.T) {
+	trusted := []netip.Prefix{mustPrefix(t, "10.0.0.0/8")}
+
+	r := httptest.NewRequest("POST", "/v1/chat/completions", nil)
+	r.RemoteAddr = "10.0.0.1:443" // the trusted reverse proxy
+	r.Header.Set("X-Forwarded-For", "198.51.100.9, 10.0.0.2")
+
+	got := ClientIP(r, trusted)
+
+	if got.String() != "198.51.100.9" {
+		t.Errorf("Expected the real client 198.51.100.9, got %q", got.String())
+	}
+}
+
+func TestClientIPFallsBackToXRealIPWhenXFFFullyTrusted(t *testing.T) {
+	trusted := []netip.Prefix{mustPrefix(t, "10.0.0.0/8")}
+
+	r := httptest.NewRequest("POST", "/v1/chat/completions", nil)
+	r.RemoteAddr = "10.0.0.1:443"
+	r.Header.Set("X-Forwarded-For", "10.0.0.2")
+	r.Header.Set("X-Real-IP", "198.51.100.9")
+
+	got := ClientIP(r, trusted)
+
+	if got.String() != "198.51.100.9" {
+		t.Errorf("Expected X-Real-IP fallback 198.51.100.9, got %q", got.String())
+	}
+}
+
+func TestClientIPFallsBackToRemoteAddrWhenNoHeaders(t *testing.T) {
+	trusted := []netip.Prefix{mustPrefix(t, "10.0.0.0/8")}
+
+	r := httptest.NewRequest("POST", "/v1/chat/completions", nil)
+	r.RemoteAddr = "10.0.0.1:443"
+
+	got := ClientIP(r, trusted)
+
+	if got.String() != "10.0.0.1" {
+		t.Errorf("Expected RemoteAddr fallback 10.0.0.1, got %q", got.String())
+	}
+}
@@ -2,12 +2,15 @@ package proxy
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"net/netip"
 	"net/url"
 	"strings"
 	"sync"
@@ -15,24 +18,38 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/truthtable/backend-go/internal/proxy/providers"
+	"github.com/truthtable/backend-go/internal/websocket"
 	"github.com/truthtable/backend-go/internal/worker"
 )
 
 // Handler is the main proxy handler that intercepts LLM requests
 type Handler struct {
+	upstreamMu  sync.RWMutex
 	upstreamURL *url.URL
-	httpClient  *http.Client
-	workerPool  *worker.Pool
+
+	httpClient     *http.Client
+	workerPool     worker.AuditBackend
+	adapters       []providers.Adapter
+	chatAdapter    providers.Adapter
+	trustedProxies []netip.Prefix
+	wsHub          *websocket.Hub
 }
 
-// NewHandler creates a new proxy handler
-func NewHandler(upstream string, pool *worker.Pool) *Handler {
+// NewHandler creates a new proxy handler. pool may be either an in-process
+// *worker.Pool or a *worker.GRPCPool - any worker.AuditBackend. If adapters
+// is omitted, the default provider set from providers.Default() is used.
+func NewHandler(upstream string, pool worker.AuditBackend, adapters ...providers.Adapter) *Handler {
 	u, err := url.Parse(upstream)
 	if err != nil {
 		log.Fatalf("Invalid upstream URL: %v", err)
 	}
 
-	return &Handler{
+	if len(adapters) == 0 {
+		adapters = providers.Default()
+	}
+
+	h := &Handler{
 		upstreamURL: u,
 		httpClient: &http.Client{
 			Timeout: 5 * time.Minute, // Long timeout for streaming
@@ -43,7 +60,94 @@ func NewHandler(upstream string, pool *worker.Pool) *Handler {
 			},
 		},
 		workerPool: pool,
+		adapters:   adapters,
+	}
+
+	h.chatAdapter = findAdapter(adapters, "openai")
+	if h.chatAdapter == nil && len(adapters) > 0 {
+		h.chatAdapter = adapters[0]
+	}
+
+	return h
+}
+
+// SetChatProvider switches the adapter HandleChatCompletion uses to tag and
+// extract prompt/response for the OpenAI-shaped /v1/chat/completions route,
+// letting operators point that route at an OpenAI-compatible provider (e.g.
+// Mistral) without code changes. It's a no-op if name isn't registered.
+func (h *Handler) SetChatProvider(name string) {
+	if a := findAdapter(h.adapters, name); a != nil {
+		h.chatAdapter = a
+	}
+}
+
+// SetTrustedProxies configures which reverse-proxy CIDRs the handler trusts
+// to set X-Forwarded-For / X-Real-IP when resolving the audit client IP.
+func (h *Handler) SetTrustedProxies(trusted []netip.Prefix) {
+	h.trustedProxies = trusted
+}
+
+// SetWSHub wires in the WebSocket hub streaming chat completions broadcast
+// audit.progress updates to as they arrive. It's a no-op to leave unset -
+// streaming still works, dashboards just don't see intermediate progress.
+func (h *Handler) SetWSHub(hub *websocket.Hub) {
+	h.wsHub = hub
+}
+
+// Upstream returns the upstream base URL requests are currently forwarded
+// to. It's a copy, safe to mutate (e.g. to set Path) without affecting the
+// Handler's own state.
+func (h *Handler) Upstream() *url.URL {
+	h.upstreamMu.RLock()
+	defer h.upstreamMu.RUnlock()
+	u := *h.upstreamURL
+	return &u
+}
+
+// SetUpstream repoints the handler at a new upstream base URL, for a
+// SIGHUP config reload. It takes effect for the next request; requests
+// already forwarding to the old upstream are unaffected.
+func (h *Handler) SetUpstream(upstream string) error {
+	u, err := url.Parse(upstream)
+	if err != nil {
+		return fmt.Errorf("invalid upstream URL: %w", err)
 	}
+	h.upstreamMu.Lock()
+	h.upstreamURL = u
+	h.upstreamMu.Unlock()
+	return nil
+}
+
+// clientIP resolves the audit-facing client address for r.
+func (h *Handler) clientIP(r *http.Request) string {
+	addr := ClientIP(r, h.trustedProxies)
+	if !addr.IsValid() {
+		return ""
+	}
+	return addr.String()
+}
+
+// findAdapter returns the adapter in adapters whose Name matches name, or nil.
+func findAdapter(adapters []providers.Adapter, name string) providers.Adapter {
+	for _, a := range adapters {
+		if a.Name() == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// adapterFor returns the first registered adapter whose MatchRoute claims r.
+// providers.Default() always ends in a catch-all passthrough adapter, so this
+// only returns nil when the handler was constructed with a custom adapter
+// list that doesn't cover every route.
+func (h *Handler) adapterFor(r *http.Request) providers.Adapter {
+	for _, a := range h.adapters {
+		if a.MatchRoute(r) {
+			return a
+		}
+	}
+	return nil
 }
 
 // ChatCompletionRequest represents the OpenAI chat completion request format
@@ -57,10 +161,46 @@ type ChatCompletionRequest struct {
 	TestResponse string        `json:"test_response,omitempty"` // For testing without real API
 }
 
-// ChatMessage represents a single message in the chat
+// ChatMessage represents a single message in the chat. Content is left as
+// raw JSON because OpenAI-shaped payloads allow it to be either a plain
+// string or a multimodal content-part array ([{type:"text",...},
+// {type:"image_url",...}]); use extractContentText to render either shape.
 type ChatMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role       string          `json:"role"`
+	Content    json.RawMessage `json:"content,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	ToolCallID string          `json:"tool_call_id,omitempty"`
+	ToolCalls  []ToolCall      `json:"tool_calls,omitempty"`
+}
+
+// ToolCall is one function call an assistant message requested.
+type ToolCall struct {
+	ID       string           `json:"id"`
+	Type     string           `json:"type"`
+	Function ToolCallFunction `json:"function"`
+}
+
+// ToolCallFunction names the function an assistant requested and the raw
+// (model-generated, not necessarily valid) JSON arguments it passed.
+type ToolCallFunction struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// contentPart is one element of a multimodal ChatMessage.Content array.
+type contentPart struct {
+	Type     string `json:"type"`
+	Text     string `json:"text,omitempty"`
+	ImageURL *struct {
+		URL string `json:"url"`
+	} `json:"image_url,omitempty"`
+}
+
+// jsonString encodes s as a JSON string, for building ChatMessage.Content
+// (which holds raw JSON) from a plain Go string.
+func jsonString(s string) json.RawMessage {
+	b, _ := json.Marshal(s)
+	return b
 }
 
 // ChatCompletionResponse represents the non-streaming response
@@ -121,19 +261,22 @@ func (h *Handler) HandleChatCompletion(c *gin.Context) {
 	}
 
 	// Extract the prompt from messages
-	prompt := extractPrompt(chatReq.Messages)
+	prompt, promptParts := extractPrompt(chatReq.Messages)
+	clientIP := h.clientIP(c.Request)
+	principal := c.GetString("principal")
+	c.Set("llm_model", chatReq.Model)
 	log.Printf("[%s] Intercepted chat completion request (model: %s, stream: %v)",
 		requestID, chatReq.Model, chatReq.Stream)
 
 	// TEST MODE: If test_response is provided, use it instead of calling upstream
 	if chatReq.TestResponse != "" {
 		log.Printf("[%s] TEST MODE: Using provided test_response", requestID)
-		h.handleTestResponse(c, requestID, prompt, chatReq)
+		h.handleTestResponse(c, requestID, prompt, clientIP, principal, promptParts, chatReq)
 		return
 	}
 
 	// Create upstream request
-	upstreamURL := *h.upstreamURL
+	upstreamURL := h.Upstream()
 	upstreamURL.Path = c.Request.URL.Path
 
 	proxyReq, err := http.NewRequest(c.Request.Method, upstreamURL.String(), bytes.NewReader(bodyBytes))
@@ -168,17 +311,27 @@ func (h *Handler) HandleChatCompletion(c *gin.Context) {
 
 	if chatReq.Stream {
 		// Handle streaming response with TeeWriter
-		h.handleStreamingResponse(c, resp, requestID, prompt, chatReq)
+		h.handleStreamingResponse(c, resp, requestID, prompt, clientIP, principal, promptParts, chatReq)
 	} else {
 		// Handle non-streaming response
-		h.handleNonStreamingResponse(c, resp, requestID, prompt, chatReq)
+		h.handleNonStreamingResponse(c, resp, requestID, prompt, clientIP, principal, promptParts, chatReq)
 	}
 }
 
-// handleStreamingResponse handles SSE streaming responses
-func (h *Handler) handleStreamingResponse(c *gin.Context, resp *http.Response, requestID, prompt string, req ChatCompletionRequest) {
-	// Create a TeeWriter to capture the response while streaming to client
-	tee := NewTeeWriter()
+// policyViolationTailEvent is sent to the client in place of the rest of the
+// upstream stream when an in-flight check truncates a response.
+const policyViolationTailEvent = `data: {"trustagent_policy_violation":true,"reason":%q}` + "\n\n" + "data: [DONE]\n\n"
+
+// handleStreamingResponse handles SSE streaming responses. Instead of
+// buffering the whole body and auditing it once at io.EOF, it parses deltas
+// incrementally through an SSEScanner so a lightweight in-flight checker can
+// run against the growing response and truncate the stream mid-flight; the
+// final audit reuses the same parsed deltas rather than re-parsing them. Each
+// chunk also broadcasts an audit.progress update over the WebSocket hub (if
+// one is configured) so dashboards can render tokens as they arrive instead
+// of waiting for the final audit result.
+func (h *Handler) handleStreamingResponse(c *gin.Context, resp *http.Response, requestID, prompt, clientIP, principal string, promptParts []worker.PromptPart, req ChatCompletionRequest) {
+	scanner := NewSSEScanner()
 
 	c.Status(resp.StatusCode)
 	c.Header("Content-Type", "text/event-stream")
@@ -193,30 +346,30 @@ func (h *Handler) handleStreamingResponse(c *gin.Context, resp *http.Response, r
 		if n > 0 {
 			// Write to client
 			w.Write(buf[:n])
-			// Capture for audit
-			tee.Write(buf[:n])
-		}
-		if err == io.EOF {
-			// Stream complete, submit audit job
-			fullResponse := tee.String()
-			extractedResponse := extractStreamingContent(fullResponse)
+			// Parse incrementally for audit
+			scanner.Write(buf[:n])
 
-			log.Printf("[%s] Stream complete, captured %d bytes, extracted: %d chars",
-				requestID, len(fullResponse), len(extractedResponse))
-
-			// Submit to worker pool for async audit
-			if h.workerPool != nil && extractedResponse != "" {
-				job := &worker.AuditJob{
+			if h.wsHub != nil {
+				h.wsHub.BroadcastAuditProgress(&websocket.AuditProgress{
 					RequestID:   requestID,
-					Prompt:      prompt,
-					Response:    extractedResponse,
 					Model:       req.Model,
-					Timestamp:   time.Now(),
-					UserID:      req.User,
-					RequestPath: "/v1/chat/completions",
+					Provider:    h.chatAdapter.Name(),
+					PartialText: scanner.Content(),
+					CharCount:   len(scanner.Content()),
+				})
+			}
+
+			if h.workerPool != nil {
+				if violation, reason := h.workerPool.CheckInFlight(scanner.Content()); violation {
+					log.Printf("[%s] In-flight policy check truncating stream: %s", requestID, reason)
+					fmt.Fprintf(w, policyViolationTailEvent, reason)
+					h.submitStreamAuditJob(requestID, prompt, clientIP, principal, promptParts, scanner, req, true, reason)
+					return false
 				}
-				h.workerPool.Submit(job)
 			}
+		}
+		if err == io.EOF {
+			h.submitStreamAuditJob(requestID, prompt, clientIP, principal, promptParts, scanner, req, false, "")
 			return false
 		}
 		if err != nil {
@@ -227,8 +380,37 @@ func (h *Handler) handleStreamingResponse(c *gin.Context, resp *http.Response, r
 	})
 }
 
+// submitStreamAuditJob submits the deltas SSEScanner has parsed so far as an
+// audit job, tagging it as truncated when an in-flight check cut the stream
+// short rather than letting it run to completion.
+func (h *Handler) submitStreamAuditJob(requestID, prompt, clientIP, principal string, promptParts []worker.PromptPart, scanner *SSEScanner, req ChatCompletionRequest, truncated bool, truncationReason string) {
+	content := scanner.Content()
+
+	log.Printf("[%s] Stream complete, extracted %d chars (truncated: %v)", requestID, len(content), truncated)
+
+	if h.workerPool == nil || content == "" {
+		return
+	}
+
+	h.workerPool.Submit(&worker.AuditJob{
+		RequestID:        requestID,
+		Prompt:           prompt,
+		PromptParts:      promptParts,
+		Response:         content,
+		Model:            req.Model,
+		Timestamp:        time.Now(),
+		UserID:           req.User,
+		ClientIP:         clientIP,
+		RequestPath:      "/v1/chat/completions",
+		Provider:         h.chatAdapter.Name(),
+		Truncated:        truncated,
+		TruncationReason: truncationReason,
+		Principal:        principal,
+	})
+}
+
 // handleNonStreamingResponse handles regular JSON responses
-func (h *Handler) handleNonStreamingResponse(c *gin.Context, resp *http.Response, requestID, prompt string, req ChatCompletionRequest) {
+func (h *Handler) handleNonStreamingResponse(c *gin.Context, resp *http.Response, requestID, prompt, clientIP, principal string, promptParts []worker.PromptPart, req ChatCompletionRequest) {
 	// Read entire response
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -239,7 +421,7 @@ func (h *Handler) handleNonStreamingResponse(c *gin.Context, resp *http.Response
 	// Parse response to extract assistant message
 	var chatResp ChatCompletionResponse
 	if err := json.Unmarshal(bodyBytes, &chatResp); err == nil && len(chatResp.Choices) > 0 {
-		responseContent := chatResp.Choices[0].Message.Content
+		responseContent := extractContentText(chatResp.Choices[0].Message.Content)
 
 		log.Printf("[%s] Non-streaming response captured (%d chars)", requestID, len(responseContent))
 
@@ -248,11 +430,15 @@ func (h *Handler) handleNonStreamingResponse(c *gin.Context, resp *http.Response
 			job := &worker.AuditJob{
 				RequestID:   requestID,
 				Prompt:      prompt,
+				PromptParts: promptParts,
 				Response:    responseContent,
 				Model:       req.Model,
 				Timestamp:   time.Now(),
 				UserID:      req.User,
+				ClientIP:    clientIP,
 				RequestPath: "/v1/chat/completions",
+				Provider:    h.chatAdapter.Name(),
+				Principal:   principal,
 			}
 			h.workerPool.Submit(job)
 		}
@@ -271,28 +457,240 @@ func (h *Handler) HandleCompletion(c *gin.Context) {
 // HandleGeneric forwards any request as-is to upstream
 func (h *Handler) HandleGeneric(c *gin.Context) {
 	// Create reverse proxy
-	proxy := httputil.NewSingleHostReverseProxy(h.upstreamURL)
+	upstream := h.Upstream()
+	proxy := httputil.NewSingleHostReverseProxy(upstream)
 	proxy.Director = func(req *http.Request) {
-		req.URL.Scheme = h.upstreamURL.Scheme
-		req.URL.Host = h.upstreamURL.Host
-		req.Host = h.upstreamURL.Host
+		req.URL.Scheme = upstream.Scheme
+		req.URL.Host = upstream.Host
+		req.Host = upstream.Host
 	}
 
 	proxy.ServeHTTP(c.Writer, c.Request)
 }
 
-// extractPrompt extracts the user prompt from chat messages
-func extractPrompt(messages []ChatMessage) string {
-	var parts []string
+// HandleLLMRequest intercepts requests to any non-OpenAI provider route
+// registered with an Adapter (e.g. Anthropic, Gemini). It forwards the body
+// upstream as-is and submits an audit job using whichever adapter claims the
+// route, falling back to a plain forward when no adapter matches.
+func (h *Handler) HandleLLMRequest(c *gin.Context) {
+	requestID := c.GetHeader("X-Request-ID")
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	adapter := h.adapterFor(c.Request)
+	if adapter == nil {
+		h.HandleGeneric(c)
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+		return
+	}
+
+	upstreamURL := h.Upstream()
+	upstreamURL.Path = c.Request.URL.Path
+
+	proxyReq, err := http.NewRequest(c.Request.Method, upstreamURL.String(), bytes.NewReader(bodyBytes))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create proxy request"})
+		return
+	}
+	for key, values := range c.Request.Header {
+		for _, value := range values {
+			proxyReq.Header.Add(key, value)
+		}
+	}
+	proxyReq.Header.Set("X-Request-ID", requestID)
+
+	resp, err := h.httpClient.Do(proxyReq)
+	if err != nil {
+		log.Printf("[%s] Upstream request failed: %v", requestID, err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Upstream request failed"})
+		return
+	}
+	defer resp.Body.Close()
+
+	for key, values := range resp.Header {
+		for _, value := range values {
+			c.Header(key, value)
+		}
+	}
+
+	clientIP := h.clientIP(c.Request)
+	principal := c.GetString("principal")
+
+	if adapter.IsStreaming(bodyBytes) || strings.HasSuffix(c.Request.URL.Path, ":streamGenerateContent") {
+		h.relayAndAuditStream(c, resp, requestID, clientIP, principal, bodyBytes, adapter)
+		return
+	}
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read upstream response"})
+		return
+	}
+
+	prompt, response, model, err := adapter.ExtractPromptResponse(bodyBytes, respBytes)
+	if model == "" && adapter.Name() == "gemini" {
+		model = providers.ModelFromPath(c.Request.URL.Path)
+	}
+	c.Set("llm_model", model)
+	if err != nil {
+		log.Printf("[%s] %s: failed to extract prompt/response: %v", requestID, adapter.Name(), err)
+	} else if h.workerPool != nil && response != "" {
+		h.workerPool.Submit(&worker.AuditJob{
+			RequestID:   requestID,
+			Prompt:      prompt,
+			Response:    response,
+			Model:       model,
+			Timestamp:   time.Now(),
+			ClientIP:    clientIP,
+			RequestPath: c.Request.URL.Path,
+			Provider:    adapter.Name(),
+			Principal:   principal,
+		})
+	}
+
+	c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), respBytes)
+}
+
+// relayAndAuditStream streams resp.Body to the client while capturing it for
+// the adapter to extract the completion text from once the stream ends.
+func (h *Handler) relayAndAuditStream(c *gin.Context, resp *http.Response, requestID, clientIP, principal string, reqBody []byte, adapter providers.Adapter) {
+	tee := NewTeeWriter()
+
+	c.Status(resp.StatusCode)
+	c.Header("X-Request-ID", requestID)
+
+	c.Stream(func(w io.Writer) bool {
+		buf := make([]byte, 1024)
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			w.Write(buf[:n])
+			tee.Write(buf[:n])
+		}
+		if err == io.EOF {
+			content := adapter.ExtractStreamingContent(tee.Bytes())
+			if h.workerPool != nil && content != "" {
+				prompt, _, model, extractErr := adapter.ExtractPromptResponse(reqBody, nil)
+				if extractErr != nil {
+					log.Printf("[%s] %s: failed to extract prompt: %v", requestID, adapter.Name(), extractErr)
+				}
+				if model == "" && adapter.Name() == "gemini" {
+					model = providers.ModelFromPath(c.Request.URL.Path)
+				}
+				c.Set("llm_model", model)
+				h.workerPool.Submit(&worker.AuditJob{
+					RequestID:   requestID,
+					Prompt:      prompt,
+					Response:    content,
+					Model:       model,
+					Timestamp:   time.Now(),
+					ClientIP:    clientIP,
+					RequestPath: c.Request.URL.Path,
+					Provider:    adapter.Name(),
+					Principal:   principal,
+				})
+			}
+			return false
+		}
+		if err != nil {
+			log.Printf("[%s] Stream read error: %v", requestID, err)
+			return false
+		}
+		return true
+	})
+}
+
+// extractPrompt walks messages into both the legacy flattened string (kept
+// for logging and the test-response token estimate) and a []worker.PromptPart
+// per message, so a downstream auditor can ground a faithfulness check in a
+// specific tool result instead of a lossy concatenated transcript.
+func extractPrompt(messages []ChatMessage) (string, []worker.PromptPart) {
+	var parts []worker.PromptPart
 	for _, msg := range messages {
-		if msg.Role == "user" || msg.Role == "system" {
-			parts = append(parts, fmt.Sprintf("[%s]: %s", msg.Role, msg.Content))
+		text := renderMessageContent(msg)
+		if text == "" {
+			continue
+		}
+		parts = append(parts, worker.PromptPart{
+			Role:       msg.Role,
+			Text:       text,
+			ToolCallID: msg.ToolCallID,
+		})
+	}
+
+	flattened := make([]string, len(parts))
+	for i, part := range parts {
+		flattened[i] = fmt.Sprintf("[%s]: %s", part.Role, part.Text)
+	}
+	return strings.Join(flattened, "\n"), parts
+}
+
+// renderMessageContent flattens a single message's text content, tool calls,
+// and (for a "tool" role message) its linked tool_call_id into one string.
+func renderMessageContent(msg ChatMessage) string {
+	var parts []string
+
+	if text := extractContentText(msg.Content); text != "" {
+		parts = append(parts, text)
+	}
+
+	for _, call := range msg.ToolCalls {
+		parts = append(parts, fmt.Sprintf("[tool_call:%s(%s)]", call.Function.Name, call.Function.Arguments))
+	}
+
+	rendered := strings.Join(parts, " ")
+	if msg.Role == "tool" && msg.ToolCallID != "" {
+		return fmt.Sprintf("[tool_result:%s]: %s", msg.ToolCallID, rendered)
+	}
+	return rendered
+}
+
+// extractContentText renders a ChatMessage.Content payload into plain text.
+// Plain string content passes through unchanged. A content-part array has
+// its "text" parts concatenated; "image_url" parts are replaced with an
+// "[image:<sha256>]" placeholder keyed on the image URL/data URI, so an
+// auditor can see an image was present without fetching or decoding it.
+func extractContentText(content json.RawMessage) string {
+	if len(content) == 0 {
+		return ""
+	}
+
+	var text string
+	if err := json.Unmarshal(content, &text); err == nil {
+		return text
+	}
+
+	var contentParts []contentPart
+	if err := json.Unmarshal(content, &contentParts); err != nil {
+		return ""
+	}
+
+	var rendered []string
+	for _, part := range contentParts {
+		switch part.Type {
+		case "text":
+			if part.Text != "" {
+				rendered = append(rendered, part.Text)
+			}
+		case "image_url":
+			if part.ImageURL != nil && part.ImageURL.URL != "" {
+				sum := sha256.Sum256([]byte(part.ImageURL.URL))
+				rendered = append(rendered, fmt.Sprintf("[image:%s]", hex.EncodeToString(sum[:])))
+			}
 		}
 	}
-	return strings.Join(parts, "\n")
+	return strings.Join(rendered, " ")
 }
 
-// extractStreamingContent parses SSE data to extract the actual content
+// extractStreamingContent parses a full SSE body in one pass. Superseded by
+// SSEScanner for handleStreamingResponse, which parses incrementally as
+// chunks arrive instead of re-scanning the reassembled body; kept for
+// callers that only have the complete body after the fact.
 func extractStreamingContent(sseData string) string {
 	var contentParts []string
 	lines := strings.Split(sseData, "\n")
@@ -356,7 +754,7 @@ func (t *TeeWriter) Bytes() []byte {
 }
 
 // handleTestResponse handles test mode requests without calling upstream
-func (h *Handler) handleTestResponse(c *gin.Context, requestID, prompt string, req ChatCompletionRequest) {
+func (h *Handler) handleTestResponse(c *gin.Context, requestID, prompt, clientIP, principal string, promptParts []worker.PromptPart, req ChatCompletionRequest) {
 	// Create a mock response
 	response := ChatCompletionResponse{
 		ID:      "chatcmpl-test-" + requestID,
@@ -368,7 +766,7 @@ func (h *Handler) handleTestResponse(c *gin.Context, requestID, prompt string, r
 				Index: 0,
 				Message: ChatMessage{
 					Role:    "assistant",
-					Content: req.TestResponse,
+					Content: jsonString(req.TestResponse),
 				},
 				FinishReason: "stop",
 			},
@@ -385,12 +783,16 @@ func (h *Handler) handleTestResponse(c *gin.Context, requestID, prompt string, r
 		log.Printf("[%s] Submitting test response for audit (prompt: %d chars, response: %d chars)",
 			requestID, len(prompt), len(req.TestResponse))
 		h.workerPool.Submit(&worker.AuditJob{
-			RequestID: requestID,
-			Prompt:    prompt,
-			Response:  req.TestResponse,
-			Model:     req.Model,
-			Timestamp: time.Now(),
-			UserID:    req.User,
+			RequestID:   requestID,
+			Prompt:      prompt,
+			PromptParts: promptParts,
+			Response:    req.TestResponse,
+			Model:       req.Model,
+			Timestamp:   time.Now(),
+			UserID:      req.User,
+			ClientIP:    clientIP,
+			Provider:    h.chatAdapter.Name(),
+			Principal:   principal,
 		})
 	}
 
@@ -7,15 +7,17 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/truthtable/backend-go/internal/websocket"
 )
 
 func TestExtractPrompt(t *testing.T) {
 	messages := []ChatMessage{
-		{Role: "system", Content: "You are a helpful assistant."},
-		{Role: "user", Content: "What is the capital of France?"},
+		{Role: "system", Content: jsonString("You are a helpful assistant.")},
+		{Role: "user", Content: jsonString("What is the capital of France?")},
 	}
 
-	prompt := extractPrompt(messages)
+	prompt, parts := extractPrompt(messages)
 
 	if !strings.Contains(prompt, "system") {
 		t.Error("Expected prompt to contain 'system'")
@@ -26,6 +28,43 @@ func TestExtractPrompt(t *testing.T) {
 	if !strings.Contains(prompt, "capital of France") {
 		t.Error("Expected prompt to contain the user's question")
 	}
+	if len(parts) != 2 {
+		t.Fatalf("Expected 2 prompt parts, got %d", len(parts))
+	}
+	if parts[1].Role != "user" || parts[1].Text != "What is the capital of France?" {
+		t.Errorf("Expected user part with question text, got %+v", parts[1])
+	}
+}
+
+func TestExtractPromptMultimodalAndToolCalls(t *testing.T) {
+	messages := []ChatMessage{
+		{Role: "user", Content: json.RawMessage(`[{"type":"text","text":"What's in this image?"},{"type":"image_url","image_url":{"url":"https://example.com/cat.png"}}]`)},
+		{
+			Role: "assistant",
+			ToolCalls: []ToolCall{
+				{ID: "call-1", Type: "function", Function: ToolCallFunction{Name: "lookup_breed", Arguments: `{"animal":"cat"}`}},
+			},
+		},
+		{Role: "tool", ToolCallID: "call-1", Content: jsonString(`{"breed":"tabby"}`)},
+	}
+
+	prompt, parts := extractPrompt(messages)
+
+	if len(parts) != 3 {
+		t.Fatalf("Expected 3 prompt parts, got %d", len(parts))
+	}
+	if !strings.Contains(parts[0].Text, "[image:") {
+		t.Errorf("Expected image placeholder in rendered text, got %q", parts[0].Text)
+	}
+	if !strings.Contains(parts[1].Text, "[tool_call:lookup_breed({\"animal\":\"cat\"})]") {
+		t.Errorf("Expected rendered tool call, got %q", parts[1].Text)
+	}
+	if parts[2].ToolCallID != "call-1" {
+		t.Errorf("Expected tool result part to carry its tool_call_id, got %q", parts[2].ToolCallID)
+	}
+	if !strings.Contains(prompt, "tabby") {
+		t.Error("Expected flattened prompt to include the tool result")
+	}
 }
 
 func TestExtractStreamingContent(t *testing.T) {
@@ -100,6 +139,56 @@ func TestNewHandler(t *testing.T) {
 	}
 }
 
+func TestSetUpstream(t *testing.T) {
+	handler := NewHandler("https://api.openai.com", nil)
+
+	if err := handler.SetUpstream("https://api.mistral.ai"); err != nil {
+		t.Fatalf("SetUpstream failed: %v", err)
+	}
+	if got := handler.Upstream().Host; got != "api.mistral.ai" {
+		t.Errorf("Expected host 'api.mistral.ai', got %q", got)
+	}
+
+	if err := handler.SetUpstream("://not-a-url"); err == nil {
+		t.Error("Expected an error for an invalid upstream URL")
+	}
+	if got := handler.Upstream().Host; got != "api.mistral.ai" {
+		t.Errorf("Expected upstream to remain unchanged after a failed SetUpstream, got %q", got)
+	}
+}
+
+func TestSetChatProvider(t *testing.T) {
+	handler := NewHandler("https://api.openai.com", nil)
+
+	if handler.chatAdapter.Name() != "openai" {
+		t.Fatalf("Expected default chat provider 'openai', got %q", handler.chatAdapter.Name())
+	}
+
+	handler.SetChatProvider("mistral")
+	if handler.chatAdapter.Name() != "mistral" {
+		t.Errorf("Expected chat provider 'mistral', got %q", handler.chatAdapter.Name())
+	}
+
+	handler.SetChatProvider("unknown-provider")
+	if handler.chatAdapter.Name() != "mistral" {
+		t.Error("Expected SetChatProvider to be a no-op for an unregistered provider name")
+	}
+}
+
+func TestSetWSHub(t *testing.T) {
+	handler := NewHandler("https://api.openai.com", nil)
+
+	if handler.wsHub != nil {
+		t.Fatal("Expected no WebSocket hub to be configured by default")
+	}
+
+	hub := websocket.NewHub()
+	handler.SetWSHub(hub)
+	if handler.wsHub != hub {
+		t.Error("Expected SetWSHub to wire in the given hub")
+	}
+}
+
 func setupMockUpstream(t *testing.T) *httptest.Server {
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/v1/chat/completions" {
@@ -114,7 +203,7 @@ func setupMockUpstream(t *testing.T) *httptest.Server {
 						Index: 0,
 						Message: ChatMessage{
 							Role:    "assistant",
-							Content: "Paris is the capital of France.",
+							Content: jsonString("Paris is the capital of France."),
 						},
 						FinishReason: "stop",
 					},
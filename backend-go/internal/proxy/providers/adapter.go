@@ -0,0 +1,42 @@
+// Package providers adapts the proxy to the request/response shape of a
+// specific upstream LLM API so the proxy Handler itself stays provider-agnostic.
+package providers
+
+import "net/http"
+
+// Adapter normalizes a provider's wire format into the plain prompt/response
+// strings the audit pipeline works with.
+type Adapter interface {
+	// Name identifies the provider (e.g. "openai", "anthropic") for audit tagging.
+	Name() string
+
+	// MatchRoute reports whether this adapter handles r.
+	MatchRoute(r *http.Request) bool
+
+	// IsStreaming reports whether reqBody (the raw request JSON) asks for a
+	// streaming response.
+	IsStreaming(reqBody []byte) bool
+
+	// ExtractPromptResponse parses a non-streaming request/response pair into
+	// the prompt, completion, and model name to audit.
+	ExtractPromptResponse(reqBody, respBody []byte) (prompt, response, model string, err error)
+
+	// ExtractStreamingContent parses a captured SSE or NDJSON response body
+	// and returns the concatenated completion text.
+	ExtractStreamingContent(body []byte) string
+}
+
+// Default returns the adapters the proxy registers out of the box, in match
+// priority order. PassthroughAdapter is last and matches everything, so it
+// must stay at the end of the slice. MistralAdapter claims the same routes as
+// OpenAIAdapter and is never reached through route matching since OpenAI is
+// registered first; Handler.SetChatProvider selects it by name instead.
+func Default() []Adapter {
+	return []Adapter{
+		NewOpenAIAdapter(),
+		NewMistralAdapter(),
+		NewAnthropicAdapter(),
+		NewGeminiAdapter(),
+		NewPassthroughAdapter(),
+	}
+}
@@ -0,0 +1,98 @@
+package providers
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOpenAIAdapterExtractPromptResponse(t *testing.T) {
+	adapter := NewOpenAIAdapter()
+
+	reqBody := []byte(`{"model":"gpt-4","messages":[{"role":"user","content":"Hello!"}]}`)
+	respBody := []byte(`{"choices":[{"message":{"role":"assistant","content":"Hi there"}}]}`)
+
+	prompt, response, model, err := adapter.ExtractPromptResponse(reqBody, respBody)
+	if err != nil {
+		t.Fatalf("ExtractPromptResponse failed: %v", err)
+	}
+	if model != "gpt-4" {
+		t.Errorf("Expected model 'gpt-4', got %q", model)
+	}
+	if response != "Hi there" {
+		t.Errorf("Expected response 'Hi there', got %q", response)
+	}
+	if prompt == "" {
+		t.Error("Expected non-empty prompt")
+	}
+}
+
+func TestAnthropicAdapterExtractPromptResponse(t *testing.T) {
+	adapter := NewAnthropicAdapter()
+
+	reqBody := []byte(`{"model":"claude-3","system":"be nice","messages":[{"role":"user","content":"Hello!"}]}`)
+	respBody := []byte(`{"content":[{"type":"text","text":"Hi there"}]}`)
+
+	prompt, response, model, err := adapter.ExtractPromptResponse(reqBody, respBody)
+	if err != nil {
+		t.Fatalf("ExtractPromptResponse failed: %v", err)
+	}
+	if model != "claude-3" {
+		t.Errorf("Expected model 'claude-3', got %q", model)
+	}
+	if response != "Hi there" {
+		t.Errorf("Expected response 'Hi there', got %q", response)
+	}
+	if prompt == "" {
+		t.Error("Expected non-empty prompt")
+	}
+}
+
+func TestMistralAdapterExtractPromptResponse(t *testing.T) {
+	adapter := NewMistralAdapter()
+
+	reqBody := []byte(`{"model":"mistral-large-latest","messages":[{"role":"user","content":"Hello!"}]}`)
+	respBody := []byte(`{"choices":[{"message":{"role":"assistant","content":"Hi there"}}]}`)
+
+	prompt, response, model, err := adapter.ExtractPromptResponse(reqBody, respBody)
+	if err != nil {
+		t.Fatalf("ExtractPromptResponse failed: %v", err)
+	}
+	if model != "mistral-large-latest" {
+		t.Errorf("Expected model 'mistral-large-latest', got %q", model)
+	}
+	if response != "Hi there" {
+		t.Errorf("Expected response 'Hi there', got %q", response)
+	}
+	if prompt == "" {
+		t.Error("Expected non-empty prompt")
+	}
+}
+
+func TestGeminiAdapterMatchRoute(t *testing.T) {
+	adapter := NewGeminiAdapter()
+
+	r := httptest.NewRequest("POST", "/v1beta/models/gemini-pro:generateContent", nil)
+	if !adapter.MatchRoute(r) {
+		t.Error("Expected MatchRoute to match generateContent suffix")
+	}
+
+	r = httptest.NewRequest("POST", "/v1/chat/completions", nil)
+	if adapter.MatchRoute(r) {
+		t.Error("Expected MatchRoute to reject unrelated paths")
+	}
+}
+
+func TestModelFromPath(t *testing.T) {
+	model := ModelFromPath("/v1beta/models/gemini-pro:generateContent")
+	if model != "gemini-pro" {
+		t.Errorf("Expected 'gemini-pro', got %q", model)
+	}
+}
+
+func TestPassthroughAdapterMatchesEverything(t *testing.T) {
+	adapter := NewPassthroughAdapter()
+	r := httptest.NewRequest("GET", "/anything", nil)
+	if !adapter.MatchRoute(r) {
+		t.Error("Expected passthrough adapter to match any route")
+	}
+}
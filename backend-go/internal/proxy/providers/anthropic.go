@@ -0,0 +1,127 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type anthropicContentBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text,omitempty"`
+}
+
+type anthropicMessage struct {
+	Role    string          `json:"role"`
+	Content json.RawMessage `json:"content"`
+}
+
+type anthropicRequest struct {
+	Model    string             `json:"model"`
+	System   string             `json:"system,omitempty"`
+	Messages []anthropicMessage `json:"messages"`
+	Stream   bool               `json:"stream,omitempty"`
+}
+
+type anthropicResponse struct {
+	Model   string                  `json:"model"`
+	Content []anthropicContentBlock `json:"content"`
+}
+
+type anthropicAdapter struct{}
+
+// NewAnthropicAdapter returns the adapter for Anthropic's /v1/messages API.
+func NewAnthropicAdapter() Adapter {
+	return &anthropicAdapter{}
+}
+
+func (a *anthropicAdapter) Name() string { return "anthropic" }
+
+func (a *anthropicAdapter) MatchRoute(r *http.Request) bool {
+	return r.URL.Path == "/v1/messages"
+}
+
+func (a *anthropicAdapter) IsStreaming(reqBody []byte) bool {
+	var req anthropicRequest
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return false
+	}
+	return req.Stream
+}
+
+func (a *anthropicAdapter) ExtractPromptResponse(reqBody, respBody []byte) (prompt, response, model string, err error) {
+	var req anthropicRequest
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return "", "", "", fmt.Errorf("anthropic: invalid request body: %w", err)
+	}
+
+	var parts []string
+	if req.System != "" {
+		parts = append(parts, fmt.Sprintf("[system]: %s", req.System))
+	}
+	for _, msg := range req.Messages {
+		parts = append(parts, fmt.Sprintf("[%s]: %s", msg.Role, contentText(msg.Content)))
+	}
+	prompt = strings.Join(parts, "\n")
+
+	var resp anthropicResponse
+	if err := json.Unmarshal(respBody, &resp); err == nil {
+		response = joinBlockText(resp.Content)
+	}
+
+	return prompt, response, req.Model, nil
+}
+
+// contentText flattens an Anthropic message's content field, which may be a
+// plain string or an array of typed content blocks.
+func contentText(raw json.RawMessage) string {
+	var asString string
+	if err := json.Unmarshal(raw, &asString); err == nil {
+		return asString
+	}
+
+	var blocks []anthropicContentBlock
+	if err := json.Unmarshal(raw, &blocks); err == nil {
+		return joinBlockText(blocks)
+	}
+
+	return ""
+}
+
+func joinBlockText(blocks []anthropicContentBlock) string {
+	var parts []string
+	for _, block := range blocks {
+		if block.Type == "text" && block.Text != "" {
+			parts = append(parts, block.Text)
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// ExtractStreamingContent parses "event: content_block_delta" SSE frames and
+// concatenates each delta's text field.
+func (a *anthropicAdapter) ExtractStreamingContent(body []byte) string {
+	var contentParts []string
+
+	for _, line := range strings.Split(string(body), "\n") {
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		var event struct {
+			Type  string `json:"type"`
+			Delta struct {
+				Text string `json:"text"`
+			} `json:"delta"`
+		}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event); err != nil {
+			continue
+		}
+		if event.Type == "content_block_delta" && event.Delta.Text != "" {
+			contentParts = append(contentParts, event.Delta.Text)
+		}
+	}
+
+	return strings.Join(contentParts, "")
+}
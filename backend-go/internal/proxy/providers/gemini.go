@@ -0,0 +1,119 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiRequest struct {
+	Contents []geminiContent `json:"contents"`
+}
+
+type geminiCandidate struct {
+	Content geminiContent `json:"content"`
+}
+
+type geminiResponse struct {
+	Candidates []geminiCandidate `json:"candidates"`
+}
+
+type geminiAdapter struct{}
+
+// NewGeminiAdapter returns the adapter for Google's generateContent /
+// streamGenerateContent APIs.
+func NewGeminiAdapter() Adapter {
+	return &geminiAdapter{}
+}
+
+func (a *geminiAdapter) Name() string { return "gemini" }
+
+func (a *geminiAdapter) MatchRoute(r *http.Request) bool {
+	return strings.HasSuffix(r.URL.Path, ":generateContent") || strings.HasSuffix(r.URL.Path, ":streamGenerateContent")
+}
+
+func (a *geminiAdapter) IsStreaming(reqBody []byte) bool {
+	// Gemini doesn't carry a stream flag in the body - it's selected via the
+	// :streamGenerateContent method suffix, so the handler decides this from
+	// the request path rather than asking the adapter.
+	return false
+}
+
+func (a *geminiAdapter) ExtractPromptResponse(reqBody, respBody []byte) (prompt, response, model string, err error) {
+	var req geminiRequest
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return "", "", "", fmt.Errorf("gemini: invalid request body: %w", err)
+	}
+
+	var parts []string
+	for _, content := range req.Contents {
+		role := content.Role
+		if role == "" {
+			role = "user"
+		}
+		parts = append(parts, fmt.Sprintf("[%s]: %s", role, joinParts(content.Parts)))
+	}
+	prompt = strings.Join(parts, "\n")
+
+	var resp geminiResponse
+	if err := json.Unmarshal(respBody, &resp); err == nil && len(resp.Candidates) > 0 {
+		response = joinParts(resp.Candidates[0].Content.Parts)
+	}
+
+	// The model name lives in the URL path (.../models/<model>:generateContent),
+	// not the body, so it's left to the caller to fill in from the request path.
+	return prompt, response, "", nil
+}
+
+func joinParts(parts []geminiPart) string {
+	var texts []string
+	for _, p := range parts {
+		if p.Text != "" {
+			texts = append(texts, p.Text)
+		}
+	}
+	return strings.Join(texts, "")
+}
+
+// ExtractStreamingContent parses the line-delimited JSON objects Gemini emits
+// for streamGenerateContent and concatenates each candidate's text parts.
+func (a *geminiAdapter) ExtractStreamingContent(body []byte) string {
+	var contentParts []string
+
+	for _, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimSpace(strings.Trim(line, ","))
+		if line == "" || line == "[" || line == "]" {
+			continue
+		}
+
+		var chunk geminiResponse
+		if err := json.Unmarshal([]byte(line), &chunk); err != nil {
+			continue
+		}
+		if len(chunk.Candidates) > 0 {
+			contentParts = append(contentParts, joinParts(chunk.Candidates[0].Content.Parts))
+		}
+	}
+
+	return strings.Join(contentParts, "")
+}
+
+// ModelFromPath extracts "gemini-pro" from a path like
+// "/v1beta/models/gemini-pro:generateContent".
+func ModelFromPath(path string) string {
+	segment := path[strings.LastIndex(path, "/")+1:]
+	if idx := strings.Index(segment, ":"); idx != -1 {
+		return segment[:idx]
+	}
+	return segment
+}
@@ -0,0 +1,108 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Mistral's chat completion API mirrors OpenAI's wire format (messages,
+// choices[].message.content, choices[].delta.content for streaming), so this
+// adapter mirrors the shapes in openai.go, claims the same routes, and
+// differs only in Name.
+type mistralChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type mistralChatRequest struct {
+	Model    string               `json:"model"`
+	Messages []mistralChatMessage `json:"messages"`
+	Stream   bool                 `json:"stream,omitempty"`
+}
+
+type mistralChoice struct {
+	Message mistralChatMessage `json:"message"`
+}
+
+type mistralChatResponse struct {
+	Model   string          `json:"model"`
+	Choices []mistralChoice `json:"choices"`
+}
+
+type mistralStreamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+type mistralAdapter struct{}
+
+// NewMistralAdapter returns the adapter for Mistral's OpenAI-compatible
+// /v1/chat/completions API. It's selected via Handler.SetChatProvider rather
+// than MatchRoute priority, since its route is identical to OpenAI's.
+func NewMistralAdapter() Adapter {
+	return &mistralAdapter{}
+}
+
+func (a *mistralAdapter) Name() string { return "mistral" }
+
+func (a *mistralAdapter) MatchRoute(r *http.Request) bool {
+	return r.URL.Path == "/v1/chat/completions" || r.URL.Path == "/v1/completions"
+}
+
+func (a *mistralAdapter) IsStreaming(reqBody []byte) bool {
+	var req mistralChatRequest
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return false
+	}
+	return req.Stream
+}
+
+func (a *mistralAdapter) ExtractPromptResponse(reqBody, respBody []byte) (prompt, response, model string, err error) {
+	var req mistralChatRequest
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return "", "", "", fmt.Errorf("mistral: invalid request body: %w", err)
+	}
+
+	var parts []string
+	for _, msg := range req.Messages {
+		if msg.Role == "user" || msg.Role == "system" {
+			parts = append(parts, fmt.Sprintf("[%s]: %s", msg.Role, msg.Content))
+		}
+	}
+	prompt = strings.Join(parts, "\n")
+
+	var resp mistralChatResponse
+	if err := json.Unmarshal(respBody, &resp); err == nil && len(resp.Choices) > 0 {
+		response = resp.Choices[0].Message.Content
+	}
+
+	return prompt, response, req.Model, nil
+}
+
+func (a *mistralAdapter) ExtractStreamingContent(body []byte) string {
+	var contentParts []string
+
+	for _, line := range strings.Split(string(body), "\n") {
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		data := strings.TrimPrefix(line, "data: ")
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk mistralStreamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err == nil {
+			if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+				contentParts = append(contentParts, chunk.Choices[0].Delta.Content)
+			}
+		}
+	}
+
+	return strings.Join(contentParts, "")
+}
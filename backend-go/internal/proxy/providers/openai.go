@@ -0,0 +1,102 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatRequest struct {
+	Model    string              `json:"model"`
+	Messages []openAIChatMessage `json:"messages"`
+	Stream   bool                `json:"stream,omitempty"`
+}
+
+type openAIChoice struct {
+	Message openAIChatMessage `json:"message"`
+}
+
+type openAIChatResponse struct {
+	Model   string         `json:"model"`
+	Choices []openAIChoice `json:"choices"`
+}
+
+type openAIStreamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+type openAIAdapter struct{}
+
+// NewOpenAIAdapter returns the adapter for OpenAI's /v1/chat/completions API.
+func NewOpenAIAdapter() Adapter {
+	return &openAIAdapter{}
+}
+
+func (a *openAIAdapter) Name() string { return "openai" }
+
+func (a *openAIAdapter) MatchRoute(r *http.Request) bool {
+	return r.URL.Path == "/v1/chat/completions" || r.URL.Path == "/v1/completions"
+}
+
+func (a *openAIAdapter) IsStreaming(reqBody []byte) bool {
+	var req openAIChatRequest
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return false
+	}
+	return req.Stream
+}
+
+func (a *openAIAdapter) ExtractPromptResponse(reqBody, respBody []byte) (prompt, response, model string, err error) {
+	var req openAIChatRequest
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return "", "", "", fmt.Errorf("openai: invalid request body: %w", err)
+	}
+
+	var parts []string
+	for _, msg := range req.Messages {
+		if msg.Role == "user" || msg.Role == "system" {
+			parts = append(parts, fmt.Sprintf("[%s]: %s", msg.Role, msg.Content))
+		}
+	}
+	prompt = strings.Join(parts, "\n")
+
+	var resp openAIChatResponse
+	if err := json.Unmarshal(respBody, &resp); err == nil && len(resp.Choices) > 0 {
+		response = resp.Choices[0].Message.Content
+	}
+
+	return prompt, response, req.Model, nil
+}
+
+func (a *openAIAdapter) ExtractStreamingContent(body []byte) string {
+	var contentParts []string
+
+	for _, line := range strings.Split(string(body), "\n") {
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		data := strings.TrimPrefix(line, "data: ")
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk openAIStreamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err == nil {
+			if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+				contentParts = append(contentParts, chunk.Choices[0].Delta.Content)
+			}
+		}
+	}
+
+	return strings.Join(contentParts, "")
+}
@@ -0,0 +1,25 @@
+package providers
+
+import "net/http"
+
+// passthroughAdapter matches any request that no other adapter claimed. It
+// forwards traffic without attempting to audit it, since its wire format is
+// unknown.
+type passthroughAdapter struct{}
+
+// NewPassthroughAdapter returns a catch-all adapter for unrecognized routes.
+func NewPassthroughAdapter() Adapter {
+	return &passthroughAdapter{}
+}
+
+func (a *passthroughAdapter) Name() string { return "passthrough" }
+
+func (a *passthroughAdapter) MatchRoute(r *http.Request) bool { return true }
+
+func (a *passthroughAdapter) IsStreaming(reqBody []byte) bool { return false }
+
+func (a *passthroughAdapter) ExtractPromptResponse(reqBody, respBody []byte) (prompt, response, model string, err error) {
+	return "", "", "", nil
+}
+
+func (a *passthroughAdapter) ExtractStreamingContent(body []byte) string { return "" }
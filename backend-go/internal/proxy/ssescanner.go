@@ -0,0 +1,118 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"strings"
+	"sync"
+)
+
+// SSEDelta is one parsed "data:" frame's incremental content, along with the
+// byte offset into the reassembled completion text where it starts.
+type SSEDelta struct {
+	Content string
+	Offset  int
+}
+
+// SSEScanner incrementally parses an OpenAI/Mistral-shaped SSE stream as
+// bytes arrive, rather than buffering the whole body to re-parse once at the
+// end. It keeps every parsed delta plus a running hash of the reassembled
+// text, so a mid-stream policy check and the final audit can share one parse
+// instead of each re-scanning the raw bytes.
+type SSEScanner struct {
+	mu      sync.Mutex
+	partial bytes.Buffer
+	deltas  []SSEDelta
+	content strings.Builder
+	hash    hash.Hash
+	done    bool
+}
+
+// NewSSEScanner returns an empty SSEScanner.
+func NewSSEScanner() *SSEScanner {
+	return &SSEScanner{hash: sha256.New()}
+}
+
+// Write feeds raw upstream bytes into the scanner, parsing every complete
+// line immediately. It satisfies io.Writer so it can sit alongside the
+// client response writer in a streaming loop.
+func (s *SSEScanner) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.partial.Write(p)
+	for {
+		buf := s.partial.Bytes()
+		idx := bytes.IndexByte(buf, '\n')
+		if idx < 0 {
+			break
+		}
+		line := strings.TrimRight(string(buf[:idx]), "\r")
+		s.partial.Next(idx + 1)
+		s.parseLine(line)
+	}
+	return len(p), nil
+}
+
+func (s *SSEScanner) parseLine(line string) {
+	if !strings.HasPrefix(line, "data: ") {
+		return
+	}
+	data := strings.TrimPrefix(line, "data: ")
+	if data == "[DONE]" {
+		s.done = true
+		return
+	}
+
+	var chunk struct {
+		Choices []struct {
+			Delta struct {
+				Content string `json:"content"`
+			} `json:"delta"`
+		} `json:"choices"`
+	}
+	if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+		return
+	}
+	if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+		return
+	}
+
+	content := chunk.Choices[0].Delta.Content
+	s.deltas = append(s.deltas, SSEDelta{Content: content, Offset: s.content.Len()})
+	s.content.WriteString(content)
+	s.hash.Write([]byte(content))
+}
+
+// Content returns the reassembled completion text from every delta parsed so far.
+func (s *SSEScanner) Content() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.content.String()
+}
+
+// Deltas returns a copy of every delta parsed so far, in arrival order.
+func (s *SSEScanner) Deltas() []SSEDelta {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]SSEDelta, len(s.deltas))
+	copy(out, s.deltas)
+	return out
+}
+
+// Hash returns the hex-encoded running SHA-256 of the reassembled text.
+func (s *SSEScanner) Hash() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return hex.EncodeToString(s.hash.Sum(nil))
+}
+
+// Done reports whether a terminating "data: [DONE]" frame has been parsed.
+func (s *SSEScanner) Done() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.done
+}
@@ -0,0 +1,52 @@
+package proxy
+
+import "testing"
+
+func TestSSEScannerParsesDeltasIncrementally(t *testing.T) {
+	scanner := NewSSEScanner()
+
+	scanner.Write([]byte(`data: {"choices":[{"delta":{"content":"Hello"}}]}` + "\n\n"))
+	scanner.Write([]byte(`data: {"choices":[{"delta":{"content":" World"}}]}` + "\n\n"))
+	scanner.Write([]byte("data: [DONE]\n"))
+
+	if got := scanner.Content(); got != "Hello World" {
+		t.Errorf("Expected content 'Hello World', got %q", got)
+	}
+
+	deltas := scanner.Deltas()
+	if len(deltas) != 2 {
+		t.Fatalf("Expected 2 deltas, got %d", len(deltas))
+	}
+	if deltas[0].Offset != 0 || deltas[1].Offset != 5 {
+		t.Errorf("Expected offsets [0, 5], got [%d, %d]", deltas[0].Offset, deltas[1].Offset)
+	}
+	if !scanner.Done() {
+		t.Error("Expected Done() to be true after a [DONE] frame")
+	}
+}
+
+func TestSSEScannerHandlesChunkBoundariesMidLine(t *testing.T) {
+	scanner := NewSSEScanner()
+
+	full := `data: {"choices":[{"delta":{"content":"Hi"}}]}` + "\n\n"
+	mid := len(full) / 2
+	scanner.Write([]byte(full[:mid]))
+	scanner.Write([]byte(full[mid:]))
+
+	if got := scanner.Content(); got != "Hi" {
+		t.Errorf("Expected content 'Hi' after a split write, got %q", got)
+	}
+}
+
+func TestSSEScannerHashIsDeterministic(t *testing.T) {
+	a := NewSSEScanner()
+	b := NewSSEScanner()
+
+	frame := []byte(`data: {"choices":[{"delta":{"content":"same"}}]}` + "\n\n")
+	a.Write(frame)
+	b.Write(frame)
+
+	if a.Hash() != b.Hash() {
+		t.Error("Expected identical content to produce identical running hashes")
+	}
+}
@@ -0,0 +1,102 @@
+// Package reload implements zero-downtime process handoff: the running
+// process hands its already-bound listener file descriptors to a freshly
+// exec'd copy of itself via os/exec's ExtraFiles, the same fd-passing
+// approach Teleport's live reload uses, so in-flight HTTP keep-alives
+// survive a redeploy instead of being cut by a listener rebind.
+package reload
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+)
+
+// envListenerCount tells a re-exec'd child how many of its inherited file
+// descriptors (starting at fd 3, the first entry in ExtraFiles) are
+// listeners it should adopt instead of binding fresh.
+const envListenerCount = "TRUTHTABLE_REEXEC_LISTENER_COUNT"
+
+// firstInheritedFD is the first fd number a child can expect a passed-down
+// listener on; fd 0-2 are stdin/stdout/stderr.
+const firstInheritedFD = 3
+
+// IsReexeced reports whether this process was started by a prior call to
+// Reexec, i.e. whether it should adopt inherited listeners via
+// InheritedListeners instead of binding fresh ones.
+func IsReexeced() bool {
+	return os.Getenv(envListenerCount) != ""
+}
+
+// InheritedListeners returns the listeners passed down by Reexec, in the
+// same order they were given to it. It returns (nil, nil) when this process
+// wasn't re-exec'd; callers should check IsReexeced first if that
+// distinction matters.
+func InheritedListeners() ([]net.Listener, error) {
+	countStr := os.Getenv(envListenerCount)
+	if countStr == "" {
+		return nil, nil
+	}
+
+	var count int
+	if _, err := fmt.Sscanf(countStr, "%d", &count); err != nil {
+		return nil, fmt.Errorf("invalid %s=%q: %w", envListenerCount, countStr, err)
+	}
+
+	listeners := make([]net.Listener, count)
+	for i := 0; i < count; i++ {
+		f := os.NewFile(uintptr(firstInheritedFD+i), fmt.Sprintf("inherited-listener-%d", i))
+		ln, err := net.FileListener(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to adopt inherited listener %d: %w", i, err)
+		}
+		f.Close()
+		listeners[i] = ln
+	}
+	return listeners, nil
+}
+
+// fileListener is satisfied by *net.TCPListener (and *net.UnixListener),
+// which expose the underlying fd as a dup'd *os.File suitable for ExtraFiles.
+type fileListener interface {
+	File() (*os.File, error)
+}
+
+// Reexec starts a copy of the running binary with the same arguments and
+// environment, passing listeners down as inherited file descriptors in the
+// same order given here. It returns once the child process has started, not
+// once it's ready to accept connections - callers should keep serving on
+// their own listeners until they're ready to shut down, so there's no gap
+// in accepted connections. The child's stdio is wired to the parent's so
+// its startup logs aren't lost.
+func Reexec(listeners ...net.Listener) (*os.Process, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve executable path: %w", err)
+	}
+
+	files := make([]*os.File, 0, len(listeners))
+	for i, ln := range listeners {
+		fl, ok := ln.(fileListener)
+		if !ok {
+			return nil, fmt.Errorf("listener %d (%T) does not support passing its fd down", i, ln)
+		}
+		f, err := fl.File()
+		if err != nil {
+			return nil, fmt.Errorf("failed to duplicate listener %d fd: %w", i, err)
+		}
+		files = append(files, f)
+	}
+
+	cmd := exec.Command(exe, os.Args[1:]...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%d", envListenerCount, len(files)))
+	cmd.ExtraFiles = files
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start child process: %w", err)
+	}
+	return cmd.Process, nil
+}
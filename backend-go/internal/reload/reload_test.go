@@ -0,0 +1,39 @@
+package reload
+
+import (
+	"os"
+	"testing"
+)
+
+func TestIsReexecedFalseByDefault(t *testing.T) {
+	os.Unsetenv(envListenerCount)
+
+	if IsReexeced() {
+		t.Error("Expected IsReexeced to be false when env var is unset")
+	}
+}
+
+func TestInheritedListenersNilWhenNotReexeced(t *testing.T) {
+	os.Unsetenv(envListenerCount)
+
+	listeners, err := InheritedListeners()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if listeners != nil {
+		t.Errorf("Expected nil listeners, got %v", listeners)
+	}
+}
+
+func TestInheritedListenersInvalidCount(t *testing.T) {
+	os.Setenv(envListenerCount, "not-a-number")
+	defer os.Unsetenv(envListenerCount)
+
+	if !IsReexeced() {
+		t.Fatal("Expected IsReexeced to be true once the env var is set")
+	}
+
+	if _, err := InheritedListeners(); err == nil {
+		t.Error("Expected an error for a non-numeric listener count")
+	}
+}
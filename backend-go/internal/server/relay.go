@@ -0,0 +1,99 @@
+package server
+
+import (
+	"log"
+	"net"
+	"sync"
+	"sync/atomic"
+)
+
+// relayListener is a net.Listener backed by a channel instead of a socket.
+// Run hands one to each http.Server per call instead of serving directly on
+// the real listener, so http.Server.Shutdown - which closes every listener
+// it's Accepted on - only ever closes this disposable relay, never the real
+// socket. accept forwards a connection pulled off the real listener; Close
+// makes Accept return net.ErrClosed, same as a real listener would.
+type relayListener struct {
+	addr   net.Addr
+	connCh chan net.Conn
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func newRelayListener(addr net.Addr) *relayListener {
+	return &relayListener{
+		addr:   addr,
+		connCh: make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+func (l *relayListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.connCh:
+		return conn, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *relayListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *relayListener) Addr() net.Addr { return l.addr }
+
+// accept hands conn to whatever is waiting on Accept, unless the relay has
+// since been closed, in which case it closes conn instead of leaking it.
+func (l *relayListener) accept(conn net.Conn) {
+	select {
+	case l.connCh <- conn:
+	case <-l.closed:
+		conn.Close()
+	}
+}
+
+// relay owns the real listener and the single long-running goroutine that
+// Accepts on it for the lifetime of the process, forwarding each connection
+// to whichever relayListener Run most recently swapped in. This is what lets
+// Run rebuild (and gracefully shut down) its http.Server on every SIGHUP
+// reload without ever touching the real socket.
+type relay struct {
+	real net.Listener
+
+	current atomic.Pointer[relayListener]
+}
+
+func newRelay(real net.Listener) *relay {
+	r := &relay{real: real}
+	go r.run()
+	return r
+}
+
+func (r *relay) run() {
+	for {
+		conn, err := r.real.Accept()
+		if err != nil {
+			// Only Server.Close tears down the real listener, so this is
+			// just that shutdown unblocking us.
+			return
+		}
+		if target := r.current.Load(); target != nil {
+			target.accept(conn)
+			continue
+		}
+		log.Printf("no active listener for %s, dropping connection from %s", r.real.Addr(), conn.RemoteAddr())
+		conn.Close()
+	}
+}
+
+// newTarget creates a fresh relayListener for Run to serve this call's
+// http.Server on and makes it the one the background accept loop forwards
+// connections to.
+func (r *relay) newTarget() *relayListener {
+	target := newRelayListener(r.real.Addr())
+	r.current.Store(target)
+	return target
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/truthtable/backend-go/internal/config"
+)
+
+func TestRelayListenerAcceptReturnsForwardedConn(t *testing.T) {
+	ln := newRelayListener(&net.TCPAddr{Port: 1234})
+	client, server := net.Pipe()
+	defer client.Close()
+
+	go ln.accept(server)
+
+	got, err := ln.Accept()
+	if err != nil {
+		t.Fatalf("Accept failed: %v", err)
+	}
+	if got != server {
+		t.Error("Expected Accept to return the forwarded connection")
+	}
+}
+
+func TestRelayListenerCloseUnblocksAccept(t *testing.T) {
+	ln := newRelayListener(&net.TCPAddr{Port: 1234})
+	ln.Close()
+
+	if _, err := ln.Accept(); err != net.ErrClosed {
+		t.Errorf("Expected net.ErrClosed after Close, got %v", err)
+	}
+}
+
+func TestRelayListenerAcceptClosesConnAfterClose(t *testing.T) {
+	ln := newRelayListener(&net.TCPAddr{Port: 1234})
+	ln.Close()
+
+	client, server := net.Pipe()
+	defer client.Close()
+
+	ln.accept(server)
+
+	buf := make([]byte, 1)
+	if _, err := server.Read(buf); err == nil {
+		t.Error("Expected the connection to be closed instead of queued once the relay is closed")
+	}
+}
+
+// TestRunSurvivesSequentialReloadsOnSameListener exercises the scenario
+// chunk2-2's SIGHUP handling depends on: a second Run call, using a rebuilt
+// handler, must keep serving real requests on the same port after the first
+// Run's Shutdown - proving Shutdown no longer closes the real listener.
+func TestRunSurvivesSequentialReloadsOnSameListener(t *testing.T) {
+	srv, err := Listen(&config.Config{ServerPort: 0, WSPort: 0})
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer srv.Close()
+
+	addr := srv.HTTPListener.Addr().String()
+
+	runOnce := func(body string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		})
+
+		runDone := make(chan error, 1)
+		go func() {
+			runDone <- srv.Run(ctx, handler, http.NotFoundHandler(), time.Second, time.Second, time.Second)
+		}()
+
+		// Give Serve a moment to start accepting on the relay before we probe it.
+		var resp *http.Response
+		var getErr error
+		for i := 0; i < 50; i++ {
+			resp, getErr = http.Get(fmt.Sprintf("http://%s/", addr))
+			if getErr == nil {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if getErr != nil {
+			cancel()
+			<-runDone
+			t.Fatalf("GET %s failed: %v", addr, getErr)
+		}
+		got, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(got) != body {
+			t.Errorf("Expected body %q, got %q", body, got)
+		}
+
+		cancel()
+		if err := <-runDone; err != nil {
+			t.Errorf("Run returned an error: %v", err)
+		}
+	}
+
+	runOnce("first stack")
+	runOnce("reloaded stack")
+}
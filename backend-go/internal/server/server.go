@@ -0,0 +1,181 @@
+// Package server splits binding the proxy's TCP listeners from serving on
+// them, mirroring the Listen/Run split argo-cd introduced in PR #9778. The
+// ports are bound exactly once per process (or adopted from a parent via
+// reload.Reexec); Run can then be called repeatedly with a freshly rebuilt
+// http.Handler whenever hot-reloaded configuration changes, without ever
+// closing and re-binding the sockets - LLM clients holding long keep-alives
+// would otherwise see that rebind as a dropped connection. Each Run serves
+// its http.Servers on a disposable relayListener rather than the real
+// socket, so the graceful Shutdown it runs on ctx cancellation - which
+// closes every listener it Accepted on - never touches the real listener;
+// see relay.go.
+//
+// Listen also layers on whatever cfg.TLSCertFile/TLSKeyFile, cfg.ACMEDomains,
+// and cfg.TrustProxyProtocol call for (see tls.go), so Run's callers always
+// get back listeners that are already terminating TLS and/or decoding PROXY
+// protocol - they don't need to know which.
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/truthtable/backend-go/internal/config"
+	"github.com/truthtable/backend-go/internal/reload"
+)
+
+// Server owns the proxy's long-lived HTTP and WebSocket listeners.
+// HTTPListener/WSListener are what Run serves on - PROXY protocol decoding
+// and/or TLS termination already layered on, per cfg - while the raw,
+// unwrapped TCP listeners are kept separately since only those support the
+// fd-passing Reexec needs for a hot restart.
+type Server struct {
+	HTTPListener net.Listener
+	WSListener   net.Listener
+
+	rawHTTPListener net.Listener
+	rawWSListener   net.Listener
+
+	httpRelay *relay
+	wsRelay   *relay
+}
+
+// Listen binds cfg.ServerPort and cfg.WSPort, or - when this process was
+// started by reload.Reexec for a hot restart - adopts the listeners passed
+// down from the parent so no in-flight connection sees a dropped socket.
+// It should be called exactly once per process; call Run as many times as
+// the handlers need to change.
+func Listen(cfg *config.Config) (*Server, error) {
+	httpListener, wsListener, err := bindOrAdopt(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tc, err := tlsConfig(cfg)
+	if err != nil {
+		httpListener.Close()
+		wsListener.Close()
+		return nil, err
+	}
+
+	wrappedHTTP := wrapListener(httpListener, cfg, tc)
+	wrappedWS := wrapListener(wsListener, cfg, tc)
+
+	return &Server{
+		HTTPListener:    wrappedHTTP,
+		WSListener:      wrappedWS,
+		rawHTTPListener: httpListener,
+		rawWSListener:   wsListener,
+		httpRelay:       newRelay(wrappedHTTP),
+		wsRelay:         newRelay(wrappedWS),
+	}, nil
+}
+
+func bindOrAdopt(cfg *config.Config) (httpLn, wsLn net.Listener, err error) {
+	if reload.IsReexeced() {
+		inherited, err := reload.InheritedListeners()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to adopt inherited listeners: %w", err)
+		}
+		if len(inherited) != 2 {
+			return nil, nil, fmt.Errorf("expected 2 inherited listeners (http, ws), got %d", len(inherited))
+		}
+		return inherited[0], inherited[1], nil
+	}
+
+	httpListener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.ServerPort))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to bind HTTP port %d: %w", cfg.ServerPort, err)
+	}
+	wsListener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.WSPort))
+	if err != nil {
+		httpListener.Close()
+		return nil, nil, fmt.Errorf("failed to bind WebSocket port %d: %w", cfg.WSPort, err)
+	}
+	return httpListener, wsListener, nil
+}
+
+// Reexec hands s's raw listeners down to a freshly started copy of this
+// binary for a hot restart. See reload.Reexec for the handoff mechanics.
+func (s *Server) Reexec() (*int, error) {
+	proc, err := reload.Reexec(s.rawHTTPListener, s.rawWSListener)
+	if err != nil {
+		return nil, err
+	}
+	pid := proc.Pid
+	return &pid, nil
+}
+
+// Close closes both listeners. Callers normally don't need this: Run leaves
+// the listeners open across calls so they can be reused by a rebuilt
+// handler, and only the final caller (on process exit) should close them -
+// which happens implicitly when the process exits, so Close mainly exists
+// for tests.
+func (s *Server) Close() error {
+	httpErr := s.rawHTTPListener.Close()
+	wsErr := s.rawWSListener.Close()
+	if httpErr != nil {
+		return httpErr
+	}
+	return wsErr
+}
+
+// Run serves httpHandler and wsHandler on s's listeners until ctx is
+// cancelled, then gives both http.Servers up to shutdownTimeout to drain
+// in-flight requests. It returns once both have stopped, and does not close
+// s's listeners - a subsequent Run call (e.g. after a SIGHUP config reload
+// rebuilt the handlers) can reuse them without ever touching the sockets:
+// each call serves on a fresh relayListener instead of the real listener, so
+// Shutdown's close lands on the relay, not the socket.
+func (s *Server) Run(ctx context.Context, httpHandler, wsHandler http.Handler, readTimeout, writeTimeout, shutdownTimeout time.Duration) error {
+	httpServer := &http.Server{
+		Handler:      httpHandler,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+	wsServer := &http.Server{
+		Handler: wsHandler,
+	}
+
+	httpTarget := s.httpRelay.newTarget()
+	wsTarget := s.wsRelay.newTarget()
+
+	serveErrs := make(chan error, 2)
+	go func() {
+		if err := httpServer.Serve(httpTarget); err != nil && err != http.ErrServerClosed {
+			serveErrs <- fmt.Errorf("HTTP server error: %w", err)
+			return
+		}
+		serveErrs <- nil
+	}()
+	go func() {
+		if err := wsServer.Serve(wsTarget); err != nil && err != http.ErrServerClosed {
+			serveErrs <- fmt.Errorf("WebSocket server error: %w", err)
+			return
+		}
+		serveErrs <- nil
+	}()
+
+	select {
+	case <-ctx.Done():
+	case err := <-serveErrs:
+		if err != nil {
+			return err
+		}
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	var shutdownErr error
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		shutdownErr = fmt.Errorf("HTTP server shutdown error: %w", err)
+	}
+	if err := wsServer.Shutdown(shutdownCtx); err != nil && shutdownErr == nil {
+		shutdownErr = fmt.Errorf("WebSocket server shutdown error: %w", err)
+	}
+	return shutdownErr
+}
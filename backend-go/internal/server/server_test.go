@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/truthtable/backend-go/internal/config"
+)
+
+func TestListenBindsEphemeralPorts(t *testing.T) {
+	srv, err := Listen(&config.Config{ServerPort: 0, WSPort: 0})
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer srv.Close()
+
+	if srv.HTTPListener.Addr().String() == "" {
+		t.Error("Expected HTTP listener to have a bound address")
+	}
+	if srv.HTTPListener.Addr().String() == srv.WSListener.Addr().String() {
+		t.Error("Expected HTTP and WebSocket listeners to bind distinct ports")
+	}
+}
+
+func TestRunServesUntilContextCancelled(t *testing.T) {
+	srv, err := Listen(&config.Config{ServerPort: 0, WSPort: 0})
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runDone := make(chan error, 1)
+	go func() {
+		runDone <- srv.Run(ctx, http.NotFoundHandler(), http.NotFoundHandler(), time.Second, time.Second, time.Second)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-runDone:
+		if err != nil {
+			t.Errorf("Expected Run to shut down cleanly, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after its context was cancelled")
+	}
+
+	// The listeners must still be open for a subsequent Run call to reuse.
+	if err := srv.HTTPListener.Close(); err != nil {
+		t.Errorf("Expected HTTP listener to still be open after Run returned: %v", err)
+	}
+}
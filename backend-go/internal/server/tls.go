@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/caddyserver/certmagic"
+	"github.com/pires/go-proxyproto"
+
+	"github.com/truthtable/backend-go/internal/config"
+)
+
+// tlsConfig builds the *tls.Config this process should terminate connections
+// with. It returns (nil, nil) when cfg has neither ACME nor a static
+// cert/key configured, in which case the listeners stay plaintext.
+func tlsConfig(cfg *config.Config) (*tls.Config, error) {
+	switch {
+	case len(cfg.ACMEDomains) > 0:
+		return acmeTLSConfig(cfg)
+	case cfg.TLSCertFile != "" && cfg.TLSKeyFile != "":
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS cert/key: %w", err)
+		}
+		return &tls.Config{Certificates: []tls.Certificate{cert}}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// acmeTLSConfig provisions certs for cfg.ACMEDomains via certmagic, which
+// also starts the HTTP-01 challenge listener on :80 and keeps the certs
+// renewed for the lifetime of the process.
+func acmeTLSConfig(cfg *config.Config) (*tls.Config, error) {
+	certmagic.DefaultACME.Email = cfg.ACMEEmail
+	certmagic.DefaultACME.Agreed = true
+	magic := certmagic.NewDefault()
+
+	if err := magic.ManageSync(context.Background(), cfg.ACMEDomains); err != nil {
+		return nil, fmt.Errorf("failed to provision ACME certificates for %v: %w", cfg.ACMEDomains, err)
+	}
+	log.Printf("✓ ACME certificates provisioned for %v (HTTP-01 challenge listener on :80)", cfg.ACMEDomains)
+	return magic.TLSConfig(), nil
+}
+
+// wrapListener layers PROXY protocol decoding and/or TLS termination around
+// a raw TCP listener, in that order - PROXY protocol's header precedes the
+// TLS handshake on the wire, so it has to be unwrapped first.
+func wrapListener(ln net.Listener, cfg *config.Config, tc *tls.Config) net.Listener {
+	if cfg.TrustProxyProtocol {
+		ln = &proxyproto.Listener{Listener: ln}
+	}
+	if tc != nil {
+		ln = tls.NewListener(ln, tc)
+	}
+	return ln
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"net"
+	"testing"
+
+	"github.com/pires/go-proxyproto"
+
+	"github.com/truthtable/backend-go/internal/config"
+)
+
+func TestTLSConfigNilWithoutCertsOrACME(t *testing.T) {
+	tc, err := tlsConfig(&config.Config{})
+	if err != nil {
+		t.Fatalf("tlsConfig failed: %v", err)
+	}
+	if tc != nil {
+		t.Error("Expected a nil TLS config when no certs or ACME domains are set")
+	}
+}
+
+func TestTLSConfigErrorsOnMissingCertFiles(t *testing.T) {
+	_, err := tlsConfig(&config.Config{TLSCertFile: "/nonexistent/cert.pem", TLSKeyFile: "/nonexistent/key.pem"})
+	if err == nil {
+		t.Error("Expected an error when the configured cert/key files don't exist")
+	}
+}
+
+func TestWrapListenerPassthroughWithoutProxyProtocolOrTLS(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("failed to bind test listener: %v", err)
+	}
+	defer ln.Close()
+
+	wrapped := wrapListener(ln, &config.Config{}, nil)
+	if wrapped != net.Listener(ln) {
+		t.Error("Expected wrapListener to return the listener unchanged when PROXY protocol and TLS are both disabled")
+	}
+}
+
+func TestWrapListenerAddsProxyProtocol(t *testing.T) {
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("failed to bind test listener: %v", err)
+	}
+	defer ln.Close()
+
+	wrapped := wrapListener(ln, &config.Config{TrustProxyProtocol: true}, nil)
+	if _, ok := wrapped.(*proxyproto.Listener); !ok {
+		t.Errorf("Expected a *proxyproto.Listener, got %T", wrapped)
+	}
+}
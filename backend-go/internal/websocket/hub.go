@@ -4,19 +4,29 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/truthtable/backend-go/internal/metrics"
 )
 
-var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 1024,
-	CheckOrigin: func(r *http.Request) bool {
-		return true
-	},
-}
+const (
+	defaultReadBufferSize        = 1024
+	defaultWriteBufferSize       = 1024
+	defaultChunkReassemblyWindow = 5 * time.Second
+)
+
+// claimsPerChunk bounds how many claims (plus their evidence) go into a single
+// audit_result_chunk frame. It is deliberately conservative relative to
+// MaxMessageBytes since claim text and evidence strings vary widely in size.
+const claimsPerChunk = 25
+
+// auditHistorySize bounds the Hub's in-memory replay buffer. Older entries
+// are dropped as new ones arrive, so a "replay" command can only ever catch a
+// late-joining client up to the last auditHistorySize results.
+const auditHistorySize = 1000
 
 // WSMessage is the wrapper format expected by the frontend
 type WSMessage struct {
@@ -41,6 +51,11 @@ type AuditResult struct {
 	Timestamp             string              `json:"timestamp"`
 	Provider              string              `json:"provider,omitempty"`
 	Model                 string              `json:"model,omitempty"`
+	ClientIP              string              `json:"client_ip,omitempty"`
+	UserID                string              `json:"user_id,omitempty"`
+	Truncated             bool                `json:"truncated,omitempty"`
+	TruncationReason      string              `json:"truncation_reason,omitempty"`
+	Principal             string              `json:"principal,omitempty"`
 }
 
 // ClaimVerification matches the frontend's claim format
@@ -51,6 +66,58 @@ type ClaimVerification struct {
 	Evidence   []string `json:"evidence"`
 }
 
+// AuditResultChunkHeader is sent first when an AuditResult is too large to fit
+// in a single frame. It carries every scalar field so the client can render
+// the audit summary before the (potentially large) claims have arrived.
+type AuditResultChunkHeader struct {
+	AuditID               string  `json:"audit_id"`
+	RequestID             string  `json:"request_id"`
+	UserQuery             string  `json:"user_query"`
+	LLMResponse           string  `json:"llm_response"`
+	FaithfulnessScore     float64 `json:"faithfulness_score"`
+	RelevancyScore        float64 `json:"relevancy_score"`
+	OverallScore          float64 `json:"overall_score"`
+	HallucinationDetected bool    `json:"hallucination_detected"`
+	ReasoningTrace        string  `json:"reasoning_trace"`
+	ProcessingTimeMs      int64   `json:"processing_time_ms"`
+	Timestamp             string  `json:"timestamp"`
+	Provider              string  `json:"provider,omitempty"`
+	Model                 string  `json:"model,omitempty"`
+	ClientIP              string  `json:"client_ip,omitempty"`
+	UserID                string  `json:"user_id,omitempty"`
+	Truncated             bool    `json:"truncated,omitempty"`
+	TruncationReason      string  `json:"truncation_reason,omitempty"`
+	Principal             string  `json:"principal,omitempty"`
+	TotalChunks           int     `json:"total_chunks"`
+}
+
+// AuditResultChunk carries a page of claims belonging to an AuditID. Seq is
+// monotonic starting at 0 so the client can reassemble deterministically even
+// if frames are delivered out of order.
+type AuditResultChunk struct {
+	AuditID string              `json:"audit_id"`
+	Seq     int                 `json:"seq"`
+	Claims  []ClaimVerification `json:"claims"`
+}
+
+// AuditResultComplete terminates a chunked delivery for AuditID.
+type AuditResultComplete struct {
+	AuditID     string `json:"audit_id"`
+	TotalChunks int    `json:"total_chunks"`
+}
+
+// AuditProgress is broadcast while a streaming chat completion is still
+// arriving, so dashboards can render tokens as they stream in instead of
+// waiting for the final AuditResult once the response - and its audit -
+// complete.
+type AuditProgress struct {
+	RequestID   string `json:"request_id"`
+	Model       string `json:"model,omitempty"`
+	Provider    string `json:"provider,omitempty"`
+	PartialText string `json:"partial_text"`
+	CharCount   int    `json:"char_count"`
+}
+
 // Legacy AuditEvent for internal use
 type AuditEvent struct {
 	Type       string      `json:"type"`
@@ -71,11 +138,100 @@ type ClaimInfo struct {
 	Confidence float64 `json:"confidence"`
 }
 
+// ClientFilter narrows which AuditResults a Client receives. A zero-value
+// ClientFilter (or a nil one, the default for a newly connected Client)
+// matches everything, preserving the original broadcast-to-everyone
+// semantics.
+type ClientFilter struct {
+	UserIDs            []string `json:"user_ids,omitempty"`
+	Principals         []string `json:"principals,omitempty"`
+	Models             []string `json:"models,omitempty"`
+	Providers          []string `json:"providers,omitempty"`
+	MinScore           float64  `json:"min_score,omitempty"`
+	OnlyHallucinations bool     `json:"only_hallucinations,omitempty"`
+	RequestIDPrefix    string   `json:"request_id_prefix,omitempty"`
+}
+
+// matches reports whether result passes every predicate set on f. A nil
+// receiver matches everything.
+func (f *ClientFilter) matches(result *AuditResult) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.UserIDs) > 0 && !containsString(f.UserIDs, result.UserID) {
+		return false
+	}
+	if len(f.Principals) > 0 && !containsString(f.Principals, result.Principal) {
+		return false
+	}
+	if len(f.Models) > 0 && !containsString(f.Models, result.Model) {
+		return false
+	}
+	if len(f.Providers) > 0 && !containsString(f.Providers, result.Provider) {
+		return false
+	}
+	if f.MinScore > 0 && result.OverallScore < f.MinScore {
+		return false
+	}
+	if f.OnlyHallucinations && !result.HallucinationDetected {
+		return false
+	}
+	if f.RequestIDPrefix != "" && !strings.HasPrefix(result.RequestID, f.RequestIDPrefix) {
+		return false
+	}
+	return true
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
+
+// clientCommand is the inbound wire format for client-initiated messages
+// (subscribe, replay). Data is deferred for per-type decoding.
+type clientCommand struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
 type Client struct {
 	hub  *Hub
 	conn *websocket.Conn
 	send chan []byte
 	id   string
+
+	filterMu sync.RWMutex
+	filter   *ClientFilter
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// setFilter atomically replaces the client's subscription filter.
+func (c *Client) setFilter(filter *ClientFilter) {
+	c.filterMu.Lock()
+	defer c.filterMu.Unlock()
+	c.filter = filter
+}
+
+// matches reports whether result passes the client's current filter.
+func (c *Client) matches(result *AuditResult) bool {
+	c.filterMu.RLock()
+	defer c.filterMu.RUnlock()
+	return c.filter.matches(result)
+}
+
+// markDone signals that the client is gone and no further sends should be
+// attempted against send. It never closes send itself, so any goroutine
+// concurrently delivering to this client (e.g. sendChunked) only needs to
+// select on done instead of risking a send on a closed channel. Safe to call
+// more than once or from multiple goroutines.
+func (c *Client) markDone() {
+	c.closeOnce.Do(func() { close(c.done) })
 }
 
 type Hub struct {
@@ -86,14 +242,59 @@ type Hub struct {
 	mu               sync.RWMutex
 	totalConnections int
 	totalBroadcasts  int
+
+	upgrader               websocket.Upgrader
+	maxMessageBytes        int
+	chunkReassemblyTimeout time.Duration
+
+	historyMu sync.Mutex
+	history   []*AuditResult
+}
+
+// HubConfig controls frame size limits and the WebSocket upgrader buffers.
+// A zero value for any field falls back to the existing hard-coded default.
+type HubConfig struct {
+	MaxMessageBytes        int
+	ReadBufferSize         int
+	WriteBufferSize        int
+	ChunkReassemblyTimeout time.Duration
 }
 
 func NewHub() *Hub {
+	return NewHubWithConfig(HubConfig{})
+}
+
+// NewHubWithConfig creates a Hub with explicit frame-size and buffer settings.
+// When MaxMessageBytes is 0, large AuditResults are sent as a single frame
+// exactly as before.
+func NewHubWithConfig(cfg HubConfig) *Hub {
+	readBufferSize := cfg.ReadBufferSize
+	if readBufferSize <= 0 {
+		readBufferSize = defaultReadBufferSize
+	}
+	writeBufferSize := cfg.WriteBufferSize
+	if writeBufferSize <= 0 {
+		writeBufferSize = defaultWriteBufferSize
+	}
+	chunkReassemblyTimeout := cfg.ChunkReassemblyTimeout
+	if chunkReassemblyTimeout <= 0 {
+		chunkReassemblyTimeout = defaultChunkReassemblyWindow
+	}
+
 	return &Hub{
 		clients:    make(map[*Client]bool),
 		broadcast:  make(chan *AuditEvent, 100),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  readBufferSize,
+			WriteBufferSize: writeBufferSize,
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+		maxMessageBytes:        cfg.MaxMessageBytes,
+		chunkReassemblyTimeout: chunkReassemblyTimeout,
 	}
 }
 
@@ -106,6 +307,7 @@ func (h *Hub) Run() {
 			h.totalConnections++
 			count := len(h.clients)
 			h.mu.Unlock()
+			metrics.SetWSConnectedClients(count)
 			log.Printf("WebSocket client connected (id: %s, total: %d)", client.id, count)
 
 			welcome := &AuditEvent{
@@ -124,10 +326,11 @@ func (h *Hub) Run() {
 			h.mu.Lock()
 			if _, ok := h.clients[client]; ok {
 				delete(h.clients, client)
-				close(client.send)
+				client.markDone()
 			}
 			count := len(h.clients)
 			h.mu.Unlock()
+			metrics.SetWSConnectedClients(count)
 			log.Printf("WebSocket client disconnected (id: %s, remaining: %d)", client.id, count)
 
 		case event := <-h.broadcast:
@@ -146,7 +349,7 @@ func (h *Hub) Run() {
 				select {
 				case client.send <- data:
 				default:
-					close(client.send)
+					client.markDone()
 					delete(h.clients, client)
 				}
 			}
@@ -163,8 +366,12 @@ func (h *Hub) Broadcast(event *AuditEvent) {
 	}
 }
 
-// BroadcastAuditResult sends an audit result in the format expected by the frontend
+// BroadcastAuditResult sends an audit result in the format expected by the frontend.
+// Results larger than MaxMessageBytes are split into audit_result_chunk frames
+// instead of a single audit_result frame.
 func (h *Hub) BroadcastAuditResult(result *AuditResult) {
+	h.recordHistory(result)
+
 	msg := WSMessage{
 		Type:      "audit_result",
 		Timestamp: time.Now().Format(time.RFC3339),
@@ -177,13 +384,22 @@ func (h *Hub) BroadcastAuditResult(result *AuditResult) {
 		return
 	}
 
+	if h.maxMessageBytes > 0 && len(data) > h.maxMessageBytes {
+		h.broadcastChunkedAuditResult(result)
+		return
+	}
+
 	h.mu.Lock()
 	h.totalBroadcasts++
 	h.mu.Unlock()
 
 	h.mu.RLock()
-	clientCount := len(h.clients)
+	clientCount := 0
 	for client := range h.clients {
+		if !client.matches(result) {
+			continue
+		}
+		clientCount++
 		select {
 		case client.send <- data:
 		default:
@@ -196,6 +412,236 @@ func (h *Hub) BroadcastAuditResult(result *AuditResult) {
 		result.AuditID, clientCount, result.OverallScore)
 }
 
+// BroadcastAuditProgress sends an in-flight streaming update to every
+// connected client, unfiltered - ClientFilter's predicates (score,
+// hallucination, ...) only make sense once an audit has actually run, which
+// progress events precede. It isn't recorded in the replay history either,
+// since it's superseded by the final audit_result for the same request.
+func (h *Hub) BroadcastAuditProgress(progress *AuditProgress) {
+	frame, err := marshalWSMessage("audit_progress", progress)
+	if err != nil {
+		log.Printf("Failed to marshal audit progress: %v", err)
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for client := range h.clients {
+		select {
+		case client.send <- frame:
+		default:
+		}
+	}
+}
+
+// recordHistory appends result to the bounded replay buffer, trimming the
+// oldest entry once auditHistorySize is exceeded.
+func (h *Hub) recordHistory(result *AuditResult) {
+	h.historyMu.Lock()
+	defer h.historyMu.Unlock()
+
+	h.history = append(h.history, result)
+	if len(h.history) > auditHistorySize {
+		h.history = h.history[len(h.history)-auditHistorySize:]
+	}
+}
+
+// replayTo resends history entries newer than since (RFC3339; all history if
+// empty or unparseable) to client, filtered by the client's current
+// subscription.
+func (h *Hub) replayTo(client *Client, since string) {
+	h.historyMu.Lock()
+	entries := make([]*AuditResult, len(h.history))
+	copy(entries, h.history)
+	h.historyMu.Unlock()
+
+	var sinceTime time.Time
+	if since != "" {
+		if t, err := time.Parse(time.RFC3339, since); err == nil {
+			sinceTime = t
+		}
+	}
+
+	replayed := 0
+	for _, result := range entries {
+		if !sinceTime.IsZero() {
+			if t, err := time.Parse(time.RFC3339, result.Timestamp); err == nil && !t.After(sinceTime) {
+				continue
+			}
+		}
+		if !client.matches(result) {
+			continue
+		}
+		h.sendResultToClient(client, result)
+		replayed++
+	}
+
+	log.Printf("Replayed %d audit result(s) to client %s (since: %q)", replayed, client.id, since)
+}
+
+// sendResultToClient delivers result to a single client, chunking it the
+// same way a live broadcast would if it exceeds maxMessageBytes.
+func (h *Hub) sendResultToClient(client *Client, result *AuditResult) {
+	msg := WSMessage{
+		Type:      "audit_result",
+		Timestamp: time.Now().Format(time.RFC3339),
+		Data:      result,
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Failed to marshal replayed audit result: %v", err)
+		return
+	}
+
+	if h.maxMessageBytes > 0 && len(data) > h.maxMessageBytes {
+		frames := buildAuditResultChunkFrames(result)
+		go h.sendChunked(client, result.AuditID, frames)
+		return
+	}
+
+	select {
+	case client.send <- data:
+	default:
+		// Client buffer full, will be cleaned up
+	}
+}
+
+// broadcastChunkedAuditResult paginates a too-large AuditResult into a header
+// frame, N claim-page frames, and a trailing complete frame, then delivers
+// them to each client in order. Each client gets its own send goroutine so a
+// slow client cannot stall delivery to the rest.
+func (h *Hub) broadcastChunkedAuditResult(result *AuditResult) {
+	frames := buildAuditResultChunkFrames(result)
+	if len(frames) == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	h.totalBroadcasts++
+	h.mu.Unlock()
+
+	h.mu.RLock()
+	clients := make([]*Client, 0, len(h.clients))
+	for client := range h.clients {
+		if client.matches(result) {
+			clients = append(clients, client)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, client := range clients {
+		go h.sendChunked(client, result.AuditID, frames)
+	}
+
+	log.Printf("Broadcast chunked audit result %s to %d clients (%d chunks)",
+		result.AuditID, len(clients), len(frames)-2)
+}
+
+// sendChunked delivers frames to client in order, abandoning the remaining
+// frames if the client's send buffer stays full past chunkReassemblyTimeout
+// so one slow client can't hold an unbounded amount of partial state. It also
+// abandons delivery as soon as client.done fires, since send is never closed
+// out from under it - a client that disconnects mid-delivery is caught here
+// instead of risking a send on a closed channel.
+func (h *Hub) sendChunked(client *Client, auditID string, frames [][]byte) {
+	timer := time.NewTimer(h.chunkReassemblyTimeout)
+	defer timer.Stop()
+
+	for i, frame := range frames {
+		if !timer.Stop() {
+			<-timer.C
+		}
+		timer.Reset(h.chunkReassemblyTimeout)
+
+		select {
+		case client.send <- frame:
+		case <-timer.C:
+			log.Printf("client %s too slow reassembling audit %s, dropping %d remaining chunk(s)",
+				client.id, auditID, len(frames)-i)
+			return
+		case <-client.done:
+			log.Printf("client %s disconnected mid-delivery of audit %s, dropping %d remaining chunk(s)",
+				client.id, auditID, len(frames)-i)
+			return
+		}
+	}
+}
+
+func buildAuditResultChunkFrames(result *AuditResult) [][]byte {
+	var frames [][]byte
+
+	totalChunks := (len(result.Claims) + claimsPerChunk - 1) / claimsPerChunk
+	if totalChunks == 0 {
+		totalChunks = 1
+	}
+
+	header := AuditResultChunkHeader{
+		AuditID:               result.AuditID,
+		RequestID:             result.RequestID,
+		UserID:                result.UserID,
+		UserQuery:             result.UserQuery,
+		LLMResponse:           result.LLMResponse,
+		FaithfulnessScore:     result.FaithfulnessScore,
+		RelevancyScore:        result.RelevancyScore,
+		OverallScore:          result.OverallScore,
+		HallucinationDetected: result.HallucinationDetected,
+		ReasoningTrace:        result.ReasoningTrace,
+		ProcessingTimeMs:      result.ProcessingTimeMs,
+		Timestamp:             result.Timestamp,
+		Provider:              result.Provider,
+		Model:                 result.Model,
+		ClientIP:              result.ClientIP,
+		Truncated:             result.Truncated,
+		TruncationReason:      result.TruncationReason,
+		Principal:             result.Principal,
+		TotalChunks:           totalChunks,
+	}
+	if frame, err := marshalWSMessage("audit_result_chunk_header", header); err == nil {
+		frames = append(frames, frame)
+	} else {
+		log.Printf("Failed to marshal audit result chunk header: %v", err)
+		return nil
+	}
+
+	for seq := 0; seq < totalChunks; seq++ {
+		start := seq * claimsPerChunk
+		end := start + claimsPerChunk
+		if end > len(result.Claims) {
+			end = len(result.Claims)
+		}
+
+		chunk := AuditResultChunk{
+			AuditID: result.AuditID,
+			Seq:     seq,
+			Claims:  result.Claims[start:end],
+		}
+		frame, err := marshalWSMessage("audit_result_chunk", chunk)
+		if err != nil {
+			log.Printf("Failed to marshal audit result chunk %d: %v", seq, err)
+			continue
+		}
+		frames = append(frames, frame)
+	}
+
+	complete := AuditResultComplete{AuditID: result.AuditID, TotalChunks: totalChunks}
+	if frame, err := marshalWSMessage("audit_result_complete", complete); err == nil {
+		frames = append(frames, frame)
+	} else {
+		log.Printf("Failed to marshal audit result complete: %v", err)
+	}
+
+	return frames
+}
+
+func marshalWSMessage(msgType string, data interface{}) ([]byte, error) {
+	return json.Marshal(WSMessage{
+		Type:      msgType,
+		Timestamp: time.Now().Format(time.RFC3339),
+		Data:      data,
+	})
+}
+
 func (h *Hub) ClientCount() int {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
@@ -209,7 +655,7 @@ func (h *Hub) Stats() (connections, broadcasts int) {
 }
 
 func ServeWS(hub *Hub, w http.ResponseWriter, r *http.Request) {
-	conn, err := upgrader.Upgrade(w, r, nil)
+	conn, err := hub.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Printf("WebSocket upgrade failed: %v", err)
 		return
@@ -225,6 +671,7 @@ func ServeWS(hub *Hub, w http.ResponseWriter, r *http.Request) {
 		conn: conn,
 		send: make(chan []byte, 256),
 		id:   clientID,
+		done: make(chan struct{}),
 	}
 
 	hub.register <- client
@@ -270,6 +717,11 @@ func (c *Client) writePump() {
 			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
 				return
 			}
+
+		case <-c.done:
+			c.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+			return
 		}
 	}
 }
@@ -295,6 +747,41 @@ func (c *Client) readPump() {
 			}
 			break
 		}
+		c.handleCommand(message)
+	}
+}
+
+// handleCommand dispatches a single inbound client message: "subscribe"
+// atomically replaces the client's filter, "replay" pulls recent history out
+// of the hub's ring buffer. Anything else is just logged, as before.
+func (c *Client) handleCommand(message []byte) {
+	var cmd clientCommand
+	if err := json.Unmarshal(message, &cmd); err != nil {
+		log.Printf("Received unparseable message from client %s: %v", c.id, err)
+		return
+	}
+
+	switch cmd.Type {
+	case "subscribe":
+		var filter ClientFilter
+		if err := json.Unmarshal(cmd.Data, &filter); err != nil {
+			log.Printf("client %s sent invalid subscribe filter: %v", c.id, err)
+			return
+		}
+		c.setFilter(&filter)
+		log.Printf("client %s updated subscription filter", c.id)
+
+	case "replay":
+		var req struct {
+			Since string `json:"since"`
+		}
+		if err := json.Unmarshal(cmd.Data, &req); err != nil {
+			log.Printf("client %s sent invalid replay request: %v", c.id, err)
+			return
+		}
+		c.hub.replayTo(c, req.Since)
+
+	default:
 		log.Printf("Received message from client %s: %s", c.id, string(message))
 	}
 }
@@ -1,6 +1,7 @@
 package websocket
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 )
@@ -69,6 +70,181 @@ func TestAuditEventJSON(t *testing.T) {
 	}
 }
 
+func TestBuildAuditResultChunkFramesPaginatesClaims(t *testing.T) {
+	claims := make([]ClaimVerification, claimsPerChunk+1)
+	for i := range claims {
+		claims[i] = ClaimVerification{Claim: "claim", Status: "SUPPORTED", Confidence: 0.9}
+	}
+	result := &AuditResult{AuditID: "audit-1", UserID: "user-42", Claims: claims}
+
+	frames := buildAuditResultChunkFrames(result)
+
+	// header + 2 claim pages + complete
+	if len(frames) != 4 {
+		t.Fatalf("Expected 4 frames, got %d", len(frames))
+	}
+
+	var header WSMessage
+	if err := json.Unmarshal(frames[0], &header); err != nil {
+		t.Fatalf("Failed to unmarshal header frame: %v", err)
+	}
+	if header.Type != "audit_result_chunk_header" {
+		t.Errorf("Expected header frame type 'audit_result_chunk_header', got %q", header.Type)
+	}
+
+	var headerData AuditResultChunkHeader
+	headerBytes, err := json.Marshal(header.Data)
+	if err != nil {
+		t.Fatalf("Failed to re-marshal header data: %v", err)
+	}
+	if err := json.Unmarshal(headerBytes, &headerData); err != nil {
+		t.Fatalf("Failed to unmarshal header data: %v", err)
+	}
+	if headerData.UserID != "user-42" {
+		t.Errorf("Expected header UserID 'user-42', got %q", headerData.UserID)
+	}
+
+	var complete WSMessage
+	if err := json.Unmarshal(frames[len(frames)-1], &complete); err != nil {
+		t.Fatalf("Failed to unmarshal complete frame: %v", err)
+	}
+	if complete.Type != "audit_result_complete" {
+		t.Errorf("Expected complete frame type 'audit_result_complete', got %q", complete.Type)
+	}
+}
+
+func TestNewHubWithConfigDefaults(t *testing.T) {
+	hub := NewHubWithConfig(HubConfig{})
+
+	if hub.chunkReassemblyTimeout != defaultChunkReassemblyWindow {
+		t.Errorf("Expected default chunk reassembly timeout %v, got %v",
+			defaultChunkReassemblyWindow, hub.chunkReassemblyTimeout)
+	}
+	if hub.maxMessageBytes != 0 {
+		t.Errorf("Expected maxMessageBytes 0 when unset, got %d", hub.maxMessageBytes)
+	}
+}
+
+func TestClientFilterMatchesEverythingWhenNil(t *testing.T) {
+	var filter *ClientFilter
+	result := &AuditResult{OverallScore: 0.1, RequestID: "req-1"}
+
+	if !filter.matches(result) {
+		t.Error("nil filter should match everything")
+	}
+}
+
+func TestClientFilterMatchesAllPredicates(t *testing.T) {
+	filter := &ClientFilter{
+		UserIDs:            []string{"user-1"},
+		Models:             []string{"gpt-4"},
+		Providers:          []string{"openai"},
+		MinScore:           0.5,
+		OnlyHallucinations: true,
+		RequestIDPrefix:    "req-",
+	}
+
+	match := &AuditResult{
+		UserID: "user-1", Model: "gpt-4", Provider: "openai",
+		OverallScore: 0.9, HallucinationDetected: true, RequestID: "req-42",
+	}
+	if !filter.matches(match) {
+		t.Error("expected result matching every predicate to match")
+	}
+
+	noMatch := *match
+	noMatch.UserID = "user-2"
+	if filter.matches(&noMatch) {
+		t.Error("expected mismatched user ID to be filtered out")
+	}
+
+	noMatch = *match
+	noMatch.OverallScore = 0.1
+	if filter.matches(&noMatch) {
+		t.Error("expected score below MinScore to be filtered out")
+	}
+
+	noMatch = *match
+	noMatch.HallucinationDetected = false
+	if filter.matches(&noMatch) {
+		t.Error("expected non-hallucination result to be filtered out when OnlyHallucinations is set")
+	}
+
+	noMatch = *match
+	noMatch.RequestID = "other-42"
+	if filter.matches(&noMatch) {
+		t.Error("expected request ID not matching the prefix to be filtered out")
+	}
+}
+
+func TestClientFilterMatchesPrincipals(t *testing.T) {
+	filter := &ClientFilter{Principals: []string{"alice"}}
+
+	if !filter.matches(&AuditResult{Principal: "alice"}) {
+		t.Error("expected a result from an allowed principal to match")
+	}
+	if filter.matches(&AuditResult{Principal: "bob"}) {
+		t.Error("expected a result from a different principal to be filtered out")
+	}
+}
+
+func TestHubRecordHistoryTrimsToAuditHistorySize(t *testing.T) {
+	hub := NewHub()
+
+	for i := 0; i < auditHistorySize+10; i++ {
+		hub.recordHistory(&AuditResult{AuditID: "audit"})
+	}
+
+	if len(hub.history) != auditHistorySize {
+		t.Errorf("Expected history trimmed to %d entries, got %d", auditHistorySize, len(hub.history))
+	}
+}
+
+func TestBroadcastAuditProgressDeliversToConnectedClients(t *testing.T) {
+	hub := NewHub()
+	client := &Client{hub: hub, send: make(chan []byte, 1), id: "client-1"}
+	hub.clients[client] = true
+
+	hub.BroadcastAuditProgress(&AuditProgress{RequestID: "req-1", PartialText: "Hello", CharCount: 5})
+
+	select {
+	case frame := <-client.send:
+		var msg WSMessage
+		if err := json.Unmarshal(frame, &msg); err != nil {
+			t.Fatalf("Failed to unmarshal broadcast frame: %v", err)
+		}
+		if msg.Type != "audit_progress" {
+			t.Errorf("Expected type 'audit_progress', got %q", msg.Type)
+		}
+	default:
+		t.Fatal("Expected a frame to be queued on the client's send channel")
+	}
+}
+
+func TestSendChunkedAbandonsDeliveryAfterClientDisconnects(t *testing.T) {
+	hub := NewHubWithConfig(HubConfig{ChunkReassemblyTimeout: time.Second})
+	client := &Client{hub: hub, send: make(chan []byte), id: "client-1", done: make(chan struct{})}
+
+	frames := [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+
+	done := make(chan struct{})
+	go func() {
+		hub.sendChunked(client, "audit-1", frames)
+		close(done)
+	}()
+
+	// Nothing drains client.send, so sendChunked blocks delivering frame 0.
+	// Simulate Run()'s unregister case marking the client done instead of
+	// closing send out from under this in-flight delivery.
+	client.markDone()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sendChunked did not abandon delivery after the client disconnected")
+	}
+}
+
 func TestGenerateClientID(t *testing.T) {
 	id1 := generateClientID()
 	time.Sleep(2 * time.Millisecond)
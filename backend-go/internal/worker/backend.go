@@ -0,0 +1,28 @@
+package worker
+
+// AuditBackend is implemented by both Pool (in-process auditing) and
+// GRPCPool (remote auditing over a gRPC stream), so callers can submit audit
+// jobs without caring which is active. TRUTHTABLE_AUDIT_BACKEND selects
+// between them.
+type AuditBackend interface {
+	// Start launches whatever background goroutines the backend needs.
+	Start()
+	// Stop shuts the backend down, draining outstanding work where possible.
+	Stop()
+	// Submit hands job to the backend for auditing.
+	Submit(job *AuditJob)
+	// QueueLength reports how many jobs are currently buffered.
+	QueueLength() int
+	// Metrics returns a snapshot of the backend's activity.
+	Metrics() Metrics
+	// SetInFlightChecker installs the hook CheckInFlight runs against a
+	// streaming response's text so far. Passing nil disables mid-stream checks.
+	SetInFlightChecker(checker InFlightChecker)
+	// CheckInFlight runs the configured InFlightChecker, if any.
+	CheckInFlight(content string) (violation bool, reason string)
+}
+
+var (
+	_ AuditBackend = (*Pool)(nil)
+	_ AuditBackend = (*GRPCPool)(nil)
+)
@@ -0,0 +1,308 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/truthtable/backend-go/internal/grpc"
+	"github.com/truthtable/backend-go/internal/websocket"
+)
+
+// BackpressurePolicy controls what GRPCPool.Submit does when the outbound
+// buffer is full.
+type BackpressurePolicy int
+
+const (
+	// BackpressureBlock blocks Submit until room frees up in the outbound buffer.
+	BackpressureBlock BackpressurePolicy = iota
+	// BackpressureDropOldest evicts the oldest buffered job to make room for
+	// the new one instead of blocking the caller.
+	BackpressureDropOldest
+)
+
+// GRPCPoolConfig configures a GRPCPool's buffering, concurrency, and shutdown behavior.
+type GRPCPoolConfig struct {
+	BufferSize      int
+	Concurrency     int
+	Backpressure    BackpressurePolicy
+	ShutdownTimeout time.Duration
+}
+
+// GRPCPool is an AuditBackend that forwards audit jobs to a remote audit
+// engine instead of processing them in-process. All submissions are
+// multiplexed over a single, long-lived AuditClient.Stream call held by the
+// pool's StreamSession, which buffers jobs and reconnects with exponential
+// backoff if the connection drops - callers never need a stateful connection
+// per job. A fixed number of concurrent goroutines drain the outbound
+// buffer into the session and wait for each job's matching result. Results
+// are converted to the websocket wire format and handed to Hub, same as the
+// in-process Pool.
+type GRPCPool struct {
+	session *grpc.StreamSession
+	wsHub   *websocket.Hub
+
+	concurrency     int
+	backpressure    BackpressurePolicy
+	shutdownTimeout time.Duration
+
+	queue  chan *AuditJob
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// stopping is closed once, at the start of Stop, to tell Submit and the
+	// workers that no more work is coming. queue itself is never closed -
+	// Submit and Stop would otherwise race a send against that close - so
+	// this is the only shutdown signal they need ahead of outright
+	// cancellation via ctx.
+	stopOnce sync.Once
+	stopping chan struct{}
+
+	mu              sync.Mutex
+	inFlightChecker InFlightChecker
+
+	droppedCount int64
+}
+
+// NewGRPCPool creates a GRPCPool that submits jobs to client over a shared
+// StreamSession and broadcasts results through hub.
+func NewGRPCPool(cfg GRPCPoolConfig, client *grpc.AuditClient, hub *websocket.Hub) *GRPCPool {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	bufferSize := cfg.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = 1000
+	}
+	concurrency := cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = 10
+	}
+	shutdownTimeout := cfg.ShutdownTimeout
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = 10 * time.Second
+	}
+
+	return &GRPCPool{
+		session:         grpc.NewStreamSession(client, grpc.DefaultStreamBackoff),
+		wsHub:           hub,
+		concurrency:     concurrency,
+		backpressure:    cfg.Backpressure,
+		shutdownTimeout: shutdownTimeout,
+		queue:           make(chan *AuditJob, bufferSize),
+		ctx:             ctx,
+		cancel:          cancel,
+		stopping:        make(chan struct{}),
+	}
+}
+
+// Start connects the shared stream session and launches the concurrent
+// workers that drain jobs into it.
+func (p *GRPCPool) Start() {
+	p.session.Start()
+	for i := 0; i < p.concurrency; i++ {
+		p.wg.Add(1)
+		go p.worker(i)
+	}
+	log.Printf("gRPC audit pool started with %d concurrent workers over one audit stream", p.concurrency)
+}
+
+// Stop stops accepting new submissions and waits up to ShutdownTimeout for
+// in-flight and already-buffered submissions to drain before cancelling them
+// outright, then tears down the stream session.
+func (p *GRPCPool) Stop() {
+	p.stopOnce.Do(func() { close(p.stopping) })
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(p.shutdownTimeout):
+		log.Printf("gRPC audit pool shutdown timed out after %v, cancelling in-flight audits", p.shutdownTimeout)
+		p.cancel()
+		<-done
+	}
+
+	p.session.Stop()
+	log.Printf("gRPC audit pool stopped")
+}
+
+// Submit enqueues job for remote auditing. Under BackpressureBlock it blocks
+// until the outbound buffer has room; under BackpressureDropOldest it evicts
+// the oldest buffered job to make room for job instead of blocking the caller.
+func (p *GRPCPool) Submit(job *AuditJob) {
+	select {
+	case <-p.stopping:
+		atomic.AddInt64(&p.droppedCount, 1)
+		log.Printf("[%s] gRPC audit pool stopped, dropping job", job.RequestID)
+		return
+	default:
+	}
+
+	if p.backpressure == BackpressureDropOldest {
+		select {
+		case p.queue <- job:
+		default:
+			select {
+			case dropped := <-p.queue:
+				atomic.AddInt64(&p.droppedCount, 1)
+				log.Printf("[%s] gRPC audit buffer full, dropping oldest queued job [%s]", job.RequestID, dropped.RequestID)
+			default:
+			}
+			select {
+			case p.queue <- job:
+			default:
+				atomic.AddInt64(&p.droppedCount, 1)
+				log.Printf("[%s] gRPC audit buffer still full after eviction, dropping job", job.RequestID)
+			}
+		}
+		return
+	}
+
+	select {
+	case p.queue <- job:
+	case <-p.stopping:
+		atomic.AddInt64(&p.droppedCount, 1)
+		log.Printf("[%s] gRPC audit pool stopped, dropping job", job.RequestID)
+	}
+}
+
+func (p *GRPCPool) QueueLength() int {
+	return len(p.queue)
+}
+
+// Metrics returns a snapshot of queue depth. InFlight, RetryCount, and
+// DeadLetterCount are always zero: GRPCPool has no local retry queue or dead
+// letter store. A job whose stream breaks before a terminal result arrives
+// is failed by the StreamSession and is not retried.
+func (p *GRPCPool) Metrics() Metrics {
+	return Metrics{
+		QueueDepth:   len(p.queue),
+		DroppedCount: atomic.LoadInt64(&p.droppedCount),
+	}
+}
+
+// SetInFlightChecker installs the hook CheckInFlight runs against a
+// streaming response's text so far. Passing nil disables mid-stream checks.
+func (p *GRPCPool) SetInFlightChecker(checker InFlightChecker) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inFlightChecker = checker
+}
+
+// CheckInFlight runs the configured InFlightChecker against content, if one
+// is set. It returns violation=false when no checker is configured.
+func (p *GRPCPool) CheckInFlight(content string) (violation bool, reason string) {
+	p.mu.Lock()
+	checker := p.inFlightChecker
+	p.mu.Unlock()
+
+	if checker == nil {
+		return false, ""
+	}
+	return checker(content)
+}
+
+func (p *GRPCPool) worker(id int) {
+	defer p.wg.Done()
+	log.Printf("gRPC audit worker %d started", id)
+
+	for {
+		select {
+		case job := <-p.queue:
+			p.processJob(job)
+		case <-p.ctx.Done():
+			log.Printf("gRPC audit worker %d stopping", id)
+			return
+		case <-p.stopping:
+			p.drainQueue(id)
+			return
+		}
+	}
+}
+
+// drainQueue processes whatever is already buffered in queue once Stop has
+// begun, then returns. queue is never closed (see stopping), so this
+// explicit non-blocking drain is what replaces the old range-until-closed
+// behavior for jobs that were already queued when Stop was called.
+func (p *GRPCPool) drainQueue(id int) {
+	for {
+		select {
+		case job := <-p.queue:
+			p.processJob(job)
+		case <-p.ctx.Done():
+			log.Printf("gRPC audit worker %d stopping (cancelled mid-drain)", id)
+			return
+		default:
+			log.Printf("gRPC audit worker %d stopping", id)
+			return
+		}
+	}
+}
+
+func (p *GRPCPool) processJob(job *AuditJob) {
+	startTime := time.Now()
+	log.Printf("[%s] Submitting job to remote audit engine", job.RequestID)
+
+	resultCh, errCh := p.session.Submit(job.RequestID, job.Prompt, job.Response)
+
+	for {
+		select {
+		case result, ok := <-resultCh:
+			if !ok {
+				resultCh = nil
+			} else if p.wsHub != nil {
+				p.wsHub.BroadcastAuditResult(toWSAuditResult(job, result, time.Since(startTime)))
+			}
+		case err := <-errCh:
+			if err != nil {
+				log.Printf("[%s] Remote audit failed: %v", job.RequestID, err)
+				if p.wsHub != nil {
+					p.wsHub.Broadcast(&websocket.AuditEvent{
+						Type:      "audit_error",
+						RequestID: job.RequestID,
+						Timestamp: time.Now(),
+						Error:     err.Error(),
+					})
+				}
+				return
+			}
+			log.Printf("[%s] Remote audit complete in %v", job.RequestID, time.Since(startTime))
+			return
+		case <-p.ctx.Done():
+			log.Printf("[%s] gRPC audit pool stopping, abandoning in-flight audit", job.RequestID)
+			go drainAuditResults(resultCh, errCh)
+			return
+		}
+	}
+}
+
+// drainAuditResults keeps reading resultCh and errCh to completion after
+// processJob gives up on a job, discarding everything. forward
+// (stream_session.go) delivers results with a plain blocking send with no
+// regard for whether anyone is still listening; without this, an abandoned
+// job's forward goroutine would wedge mid-send the moment processJob stopped
+// draining, and since that job stays in StreamSession's pending map until a
+// terminal result arrives, every later update for it would back up
+// job.updates until dispatch itself blocked - stalling the shared stream's
+// Recv loop for every other multiplexed job, not just this one.
+func drainAuditResults(resultCh <-chan *grpc.AuditResult, errCh <-chan error) {
+	for resultCh != nil || errCh != nil {
+		select {
+		case _, ok := <-resultCh:
+			if !ok {
+				resultCh = nil
+			}
+		case _, ok := <-errCh:
+			if !ok {
+				errCh = nil
+			}
+		}
+	}
+}
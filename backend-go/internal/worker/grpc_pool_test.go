@@ -0,0 +1,149 @@
+package worker
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/truthtable/backend-go/internal/grpc"
+)
+
+func TestNewGRPCPoolDefaults(t *testing.T) {
+	pool := NewGRPCPool(GRPCPoolConfig{}, nil, nil)
+
+	if cap(pool.queue) != 1000 {
+		t.Errorf("Expected default buffer size 1000, got %d", cap(pool.queue))
+	}
+	if pool.concurrency != 10 {
+		t.Errorf("Expected default concurrency 10, got %d", pool.concurrency)
+	}
+}
+
+func TestGRPCPoolQueueLength(t *testing.T) {
+	pool := NewGRPCPool(GRPCPoolConfig{BufferSize: 10}, nil, nil)
+
+	if pool.QueueLength() != 0 {
+		t.Errorf("Expected queue length 0, got %d", pool.QueueLength())
+	}
+
+	pool.Submit(&AuditJob{RequestID: "req-1"})
+
+	if pool.QueueLength() != 1 {
+		t.Errorf("Expected queue length 1, got %d", pool.QueueLength())
+	}
+}
+
+func TestGRPCPoolDropOldestEvictsUnderFullBuffer(t *testing.T) {
+	pool := NewGRPCPool(GRPCPoolConfig{BufferSize: 1, Backpressure: BackpressureDropOldest}, nil, nil)
+
+	pool.Submit(&AuditJob{RequestID: "req-1"})
+	pool.Submit(&AuditJob{RequestID: "req-2"})
+
+	if pool.QueueLength() != 1 {
+		t.Fatalf("Expected queue length 1 after eviction, got %d", pool.QueueLength())
+	}
+
+	job := <-pool.queue
+	if job.RequestID != "req-2" {
+		t.Errorf("Expected the newest job to survive eviction, got %q", job.RequestID)
+	}
+}
+
+func TestGRPCPoolMetricsDroppedCount(t *testing.T) {
+	pool := NewGRPCPool(GRPCPoolConfig{BufferSize: 1, Backpressure: BackpressureDropOldest}, nil, nil)
+
+	pool.Submit(&AuditJob{RequestID: "req-1"})
+	pool.Submit(&AuditJob{RequestID: "req-2"})
+
+	if got := pool.Metrics().DroppedCount; got != 1 {
+		t.Errorf("Expected dropped count 1, got %d", got)
+	}
+}
+
+// TestGRPCPoolSubmitDoesNotRaceStopClose drives concurrent Submit calls
+// against a real Stop with a tiny buffer, without Start (which needs a live
+// AuditClient) - queue is never closed, so this would only panic if Submit
+// or Stop still raced a send against a close.
+func TestGRPCPoolSubmitDoesNotRaceStopClose(t *testing.T) {
+	pool := NewGRPCPool(GRPCPoolConfig{Concurrency: 1, BufferSize: 1, ShutdownTimeout: time.Millisecond}, nil, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pool.Submit(&AuditJob{RequestID: "race"})
+		}()
+	}
+	pool.Stop()
+	wg.Wait()
+}
+
+// TestDrainAuditResultsReturnsOnceBothChannelsClose guards against a
+// regression where processJob abandoned a job on ctx.Done() without
+// continuing to read its resultCh/errCh. forward (stream_session.go)
+// delivers results with a plain blocking send, so an abandoned job's forward
+// goroutine would wedge mid-send forever the instant nothing read resultCh -
+// and since that job stays in StreamSession's pending map until a terminal
+// result arrives, every later update for it would back up until dispatch
+// itself blocked, stalling the shared stream's Recv loop for every other
+// multiplexed job. drainAuditResults is what keeps a reader on both channels
+// after abandonment so that can't happen.
+func TestDrainAuditResultsReturnsOnceBothChannelsClose(t *testing.T) {
+	resultCh := make(chan *grpc.AuditResult)
+	errCh := make(chan error, 1)
+
+	drained := make(chan struct{})
+	go func() {
+		drainAuditResults(resultCh, errCh)
+		close(drained)
+	}()
+
+	// Mirrors forward()'s blocking send: without a reader on the other end,
+	// each of these would never return.
+	for i := 0; i < 33; i++ {
+		select {
+		case resultCh <- &grpc.AuditResult{TrustScore: 0.1}:
+		case <-time.After(time.Second):
+			t.Fatalf("send %d blocked: drainAuditResults is not reading resultCh", i)
+		}
+	}
+	close(resultCh)
+	close(errCh)
+
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		t.Fatal("drainAuditResults did not return after both channels closed")
+	}
+}
+
+func TestGRPCPoolCheckInFlightWithNoCheckerConfigured(t *testing.T) {
+	pool := NewGRPCPool(GRPCPoolConfig{}, nil, nil)
+
+	violation, reason := pool.CheckInFlight("some content")
+
+	if violation {
+		t.Error("Expected no violation when no checker is configured")
+	}
+	if reason != "" {
+		t.Errorf("Expected empty reason, got %q", reason)
+	}
+}
+
+func TestGRPCPoolCheckInFlightRunsConfiguredChecker(t *testing.T) {
+	pool := NewGRPCPool(GRPCPoolConfig{}, nil, nil)
+	pool.SetInFlightChecker(func(content string) (bool, string) {
+		return content == "blocked", "policy violation"
+	})
+
+	violation, reason := pool.CheckInFlight("blocked")
+	if !violation || reason != "policy violation" {
+		t.Errorf("Expected violation with reason 'policy violation', got violation=%v reason=%q", violation, reason)
+	}
+
+	violation, _ = pool.CheckInFlight("fine")
+	if violation {
+		t.Error("Expected no violation for non-matching content")
+	}
+}
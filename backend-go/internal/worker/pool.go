@@ -3,7 +3,9 @@ package worker
 import (
 	"context"
 	"log"
+	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/truthtable/backend-go/internal/grpc"
@@ -11,65 +13,276 @@ import (
 )
 
 type AuditJob struct {
-	RequestID   string
-	Prompt      string
-	Response    string
-	Model       string
-	Timestamp   time.Time
-	UserID      string
-	RequestPath string
+	RequestID        string
+	Prompt           string
+	PromptParts      []PromptPart
+	Response         string
+	Model            string
+	Timestamp        time.Time
+	UserID           string
+	ClientIP         string
+	RequestPath      string
+	Provider         string
+	Attempt          int
+	Truncated        bool
+	TruncationReason string
+	// Principal is the authenticated caller identity resolved by
+	// auth.Middleware, if auth is enabled. Empty when auth is disabled.
+	Principal string
+}
+
+// PromptPart is one structured element of a chat request - one message's
+// worth of rendered text, tagged with its role and (for a tool result) the
+// tool_call_id it answers. Carrying these alongside the flattened Prompt
+// string lets an auditor ground a faithfulness check in a specific tool
+// output instead of a lossy concatenated transcript.
+type PromptPart struct {
+	Role       string
+	Text       string
+	ToolCallID string
+}
+
+// InFlightChecker is a lightweight, synchronous hook run against a streaming
+// response's text so far, for mid-stream policy interception. Unlike the
+// full async audit, it runs on the request's hot path between chunks, so it
+// must return quickly (a regex or a cheap classifier, not another network
+// call).
+type InFlightChecker func(content string) (violation bool, reason string)
+
+// RetryPolicy controls how a failed audit is retried before being dead-lettered.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy is used when a PoolConfig leaves RetryPolicy zero-valued.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// nextDelay returns an exponential backoff with full jitter for the given attempt (1-indexed).
+func (r RetryPolicy) nextDelay(attempt int) time.Duration {
+	delay := r.BaseDelay << uint(attempt-1)
+	if delay <= 0 || delay > r.MaxDelay {
+		delay = r.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// PoolConfig configures a Pool's durability and backpressure behavior.
+type PoolConfig struct {
+	Workers       int
+	QueueSize     int
+	Store         JobStore // nil defaults to an in-memory store (current behavior)
+	SubmitTimeout time.Duration
+	RetryPolicy   RetryPolicy
+}
+
+// Metrics is a point-in-time snapshot of Pool activity for operators.
+type Metrics struct {
+	QueueDepth      int
+	InFlight        int
+	RetryCount      int64
+	DeadLetterCount int64
+	DroppedCount    int64
 }
 
 type Pool struct {
-	workers     int
-	queue       chan *AuditJob
-	auditClient *grpc.AuditClient
-	wsHub       *websocket.Hub
-	wg          sync.WaitGroup
-	ctx         context.Context
-	cancel      context.CancelFunc
+	workers       int
+	queue         chan *AuditJob
+	store         JobStore
+	submitTimeout time.Duration
+	retryPolicy   RetryPolicy
+	auditClient   *grpc.AuditClient
+	wsHub         *websocket.Hub
+	wg            sync.WaitGroup
+	ctx           context.Context
+	cancel        context.CancelFunc
+
+	// closeMu guards closing: Stop takes the write lock to set closing and
+	// close queue, while Submit and drainStore take the read lock around
+	// their own send to queue. That serializes every send against Stop's
+	// close, so neither can ever race a send onto an already-closed queue.
+	closeMu sync.RWMutex
+	closing bool
+
+	inFlight     int64
+	retryCount   int64
+	droppedCount int64
+
+	inFlightChecker InFlightChecker
 }
 
-func NewPool(numWorkers, queueSize int, client *grpc.AuditClient, hub *websocket.Hub) *Pool {
+func NewPool(cfg PoolConfig, client *grpc.AuditClient, hub *websocket.Hub) *Pool {
 	ctx, cancel := context.WithCancel(context.Background())
+
+	store := cfg.Store
+	if store == nil {
+		store = newMemoryJobStore()
+	}
+
+	retryPolicy := cfg.RetryPolicy
+	if retryPolicy.MaxAttempts == 0 {
+		retryPolicy = DefaultRetryPolicy
+	}
+
+	submitTimeout := cfg.SubmitTimeout
+	if submitTimeout <= 0 {
+		submitTimeout = 50 * time.Millisecond
+	}
+
 	return &Pool{
-		workers:     numWorkers,
-		queue:       make(chan *AuditJob, queueSize),
-		auditClient: client,
-		wsHub:       hub,
-		ctx:         ctx,
-		cancel:      cancel,
+		workers:       cfg.Workers,
+		queue:         make(chan *AuditJob, cfg.QueueSize),
+		store:         store,
+		submitTimeout: submitTimeout,
+		retryPolicy:   retryPolicy,
+		auditClient:   client,
+		wsHub:         hub,
+		ctx:           ctx,
+		cancel:        cancel,
 	}
 }
 
+// Start launches the worker goroutines and, after recovering any jobs left
+// in-flight by a prior crash, a drainer that replays persisted jobs into the
+// queue as capacity frees up.
 func (p *Pool) Start() {
+	if err := p.store.Recover(); err != nil {
+		log.Printf("Job store recovery failed: %v", err)
+	}
+
 	for i := 0; i < p.workers; i++ {
 		p.wg.Add(1)
 		go p.worker(i)
 	}
+
+	p.wg.Add(1)
+	go p.drainStore()
+
 	log.Printf("Worker pool started with %d workers", p.workers)
 }
 
 func (p *Pool) Stop() {
 	p.cancel()
+
+	p.closeMu.Lock()
+	p.closing = true
 	close(p.queue)
+	p.closeMu.Unlock()
+
 	p.wg.Wait()
 	log.Printf("Worker pool stopped")
 }
 
+// Submit blocks up to SubmitTimeout for room in the in-memory queue before
+// falling back to persisting the job in the JobStore for later replay. It
+// holds closeMu for the read side of that attempt so it can never observe
+// queue as open and then race Stop's close.
 func (p *Pool) Submit(job *AuditJob) {
-	select {
-	case p.queue <- job:
-		log.Printf("[%s] Job submitted to worker pool", job.RequestID)
-	default:
-		log.Printf("[%s] Worker queue full, dropping audit job", job.RequestID)
+	p.closeMu.RLock()
+	if !p.closing {
+		select {
+		case p.queue <- job:
+			p.closeMu.RUnlock()
+			log.Printf("[%s] Job submitted to worker pool", job.RequestID)
+			return
+		case <-time.After(p.submitTimeout):
+		}
+	}
+	p.closeMu.RUnlock()
+
+	if err := p.store.Enqueue(job); err != nil {
+		atomic.AddInt64(&p.droppedCount, 1)
+		log.Printf("[%s] Worker queue full and job store write failed, dropping audit job: %v", job.RequestID, err)
+		return
 	}
+	log.Printf("[%s] Worker queue full, persisted audit job for later replay", job.RequestID)
 }
 
 func (p *Pool) QueueLength() int {
 	return len(p.queue)
 }
 
+// SetInFlightChecker installs the hook CheckInFlight runs against a
+// streaming response's text so far. Passing nil disables mid-stream checks.
+func (p *Pool) SetInFlightChecker(checker InFlightChecker) {
+	p.inFlightChecker = checker
+}
+
+// CheckInFlight runs the configured InFlightChecker against content, if one
+// is set, so a streaming handler can decide whether to truncate the
+// response before the full audit ever runs. It returns violation=false when
+// no checker is configured.
+func (p *Pool) CheckInFlight(content string) (violation bool, reason string) {
+	if p.inFlightChecker == nil {
+		return false, ""
+	}
+	return p.inFlightChecker(content)
+}
+
+// Metrics returns a snapshot of queue depth, in-flight jobs, and retry/dead-letter counts.
+func (p *Pool) Metrics() Metrics {
+	return Metrics{
+		QueueDepth:      len(p.queue),
+		InFlight:        int(atomic.LoadInt64(&p.inFlight)),
+		RetryCount:      atomic.LoadInt64(&p.retryCount),
+		DeadLetterCount: int64(p.store.DeadLetterCount()),
+		DroppedCount:    atomic.LoadInt64(&p.droppedCount),
+	}
+}
+
+// drainStore feeds persisted jobs back into the queue as room becomes
+// available, so jobs that overflowed Submit (or survived a restart) still
+// get processed without the in-memory queue growing unbounded.
+func (p *Pool) drainStore() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			job, ok, err := p.store.Dequeue()
+			if err != nil {
+				log.Printf("Job store dequeue failed: %v", err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			if !p.enqueueFromStore(job) {
+				return
+			}
+		}
+	}
+}
+
+// enqueueFromStore pushes a replayed job back onto the in-memory queue,
+// guarded by the same closeMu Stop takes before closing queue, so a replay
+// can never race that close. It reports whether the job was enqueued; false
+// means the pool is stopping and drainStore should exit.
+func (p *Pool) enqueueFromStore(job *AuditJob) bool {
+	p.closeMu.RLock()
+	defer p.closeMu.RUnlock()
+
+	if p.closing {
+		return false
+	}
+	select {
+	case p.queue <- job:
+		return true
+	case <-p.ctx.Done():
+		return false
+	}
+}
+
 func (p *Pool) worker(id int) {
 	defer p.wg.Done()
 	log.Printf("Worker %d started", id)
@@ -90,16 +303,29 @@ func (p *Pool) worker(id int) {
 }
 
 func (p *Pool) processJob(workerID int, job *AuditJob) {
+	atomic.AddInt64(&p.inFlight, 1)
+	defer atomic.AddInt64(&p.inFlight, -1)
+
 	startTime := time.Now()
-	log.Printf("[%s] Worker %d processing job", job.RequestID, workerID)
+	log.Printf("[%s] Worker %d processing job (attempt %d)", job.RequestID, workerID, job.Attempt+1)
 
 	if p.auditClient == nil {
 		log.Printf("[%s] No audit client available, skipping audit", job.RequestID)
+		p.ack(job)
 		return
 	}
 
-	result, err := p.auditClient.Evaluate(p.ctx, job.RequestID, job.Prompt, job.Response)
-	if err != nil {
+	resultCh, errCh := p.auditClient.EvaluateStream(p.ctx, job.RequestID, job.Prompt, job.Response)
+
+	var last *grpc.AuditResult
+	for result := range resultCh {
+		last = result
+		if p.wsHub != nil {
+			p.wsHub.BroadcastAuditResult(toWSAuditResult(job, result, time.Since(startTime)))
+		}
+	}
+
+	if err := <-errCh; err != nil {
 		log.Printf("[%s] Audit failed: %v", job.RequestID, err)
 		if p.wsHub != nil {
 			p.wsHub.Broadcast(&websocket.AuditEvent{
@@ -109,32 +335,75 @@ func (p *Pool) processJob(workerID int, job *AuditJob) {
 				Error:     err.Error(),
 			})
 		}
+		p.retryOrDeadLetter(job, err)
 		return
 	}
 
 	duration := time.Since(startTime)
 	log.Printf("[%s] Audit complete in %v (score: %.2f, claims: %d)",
-		job.RequestID, duration, result.TrustScore, len(result.Claims))
-
-	if p.wsHub != nil {
-		// Create audit result in the format expected by the frontend
-		auditResult := &websocket.AuditResult{
-			AuditID:               job.RequestID, // Use request ID as audit ID for now
-			RequestID:             job.RequestID,
-			UserQuery:             job.Prompt,
-			LLMResponse:           job.Response,
-			FaithfulnessScore:     result.TrustScore,
-			RelevancyScore:        result.TrustScore, // Same for now
-			OverallScore:          result.TrustScore,
-			HallucinationDetected: result.TrustScore < 0.8,
-			Claims:                convertClaimsToVerifications(result.Claims),
-			ReasoningTrace:        "",
-			ProcessingTimeMs:      duration.Milliseconds(),
-			Timestamp:             time.Now().Format(time.RFC3339),
-			Provider:              "proxy",
-			Model:                 job.Model,
+		job.RequestID, duration, last.TrustScore, len(last.Claims))
+	p.ack(job)
+}
+
+func (p *Pool) ack(job *AuditJob) {
+	if err := p.store.Ack(job.RequestID); err != nil {
+		log.Printf("[%s] Failed to ack job in store: %v", job.RequestID, err)
+	}
+}
+
+// retryOrDeadLetter schedules job for another attempt with exponential
+// backoff and jitter, or dead-letters it once RetryPolicy.MaxAttempts is exhausted.
+func (p *Pool) retryOrDeadLetter(job *AuditJob, cause error) {
+	job.Attempt++
+	if job.Attempt >= p.retryPolicy.MaxAttempts {
+		log.Printf("[%s] Exhausted %d attempts, dead-lettering job", job.RequestID, job.Attempt)
+		if err := p.store.DeadLetter(job, cause.Error()); err != nil {
+			log.Printf("[%s] Failed to dead-letter job: %v", job.RequestID, err)
 		}
-		p.wsHub.BroadcastAuditResult(auditResult)
+		return
+	}
+
+	atomic.AddInt64(&p.retryCount, 1)
+	delay := p.retryPolicy.nextDelay(job.Attempt)
+	log.Printf("[%s] Retrying in %v (attempt %d/%d)", job.RequestID, delay, job.Attempt+1, p.retryPolicy.MaxAttempts)
+
+	// Submit itself now guards its send with closeMu, so this timer can fire
+	// at any point relative to Stop (even after) without racing queue's close.
+	time.AfterFunc(delay, func() {
+		p.Submit(job)
+	})
+}
+
+// toWSAuditResult converts an incremental or terminal grpc.AuditResult into the
+// websocket wire format, computing the running duration as of this update.
+// Shared by Pool and GRPCPool, since both forward grpc.AuditResult values
+// (Pool from EvaluateStream, GRPCPool from its StreamSession).
+func toWSAuditResult(job *AuditJob, result *grpc.AuditResult, elapsed time.Duration) *websocket.AuditResult {
+	provider := job.Provider
+	if provider == "" {
+		provider = "proxy"
+	}
+
+	return &websocket.AuditResult{
+		AuditID:               job.RequestID, // Use request ID as audit ID for now
+		RequestID:             job.RequestID,
+		UserQuery:             job.Prompt,
+		LLMResponse:           job.Response,
+		FaithfulnessScore:     result.TrustScore,
+		RelevancyScore:        result.TrustScore, // Same for now
+		OverallScore:          result.TrustScore,
+		HallucinationDetected: result.TrustScore < 0.8,
+		Claims:                convertClaimsToVerifications(result.Claims),
+		ReasoningTrace:        "",
+		ProcessingTimeMs:      elapsed.Milliseconds(),
+		Timestamp:             time.Now().Format(time.RFC3339),
+		Provider:              provider,
+		Model:                 job.Model,
+		ClientIP:              job.ClientIP,
+		UserID:                job.UserID,
+		Truncated:             job.Truncated,
+		TruncationReason:      job.TruncationReason,
+		Principal:             job.Principal,
 	}
 }
 
@@ -1,12 +1,17 @@
 package worker
 
 import (
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/truthtable/backend-go/internal/grpc"
 )
 
 func TestNewPool(t *testing.T) {
-	pool := NewPool(5, 100, nil, nil)
+	pool := NewPool(PoolConfig{Workers: 5, QueueSize: 100}, nil, nil)
 
 	if pool == nil {
 		t.Fatal("NewPool returned nil")
@@ -20,13 +25,46 @@ func TestNewPool(t *testing.T) {
 }
 
 func TestPoolQueueLength(t *testing.T) {
-	pool := NewPool(2, 10, nil, nil)
+	pool := NewPool(PoolConfig{Workers: 2, QueueSize: 10}, nil, nil)
 
 	if pool.QueueLength() != 0 {
 		t.Errorf("Expected queue length 0, got %d", pool.QueueLength())
 	}
 }
 
+func TestPoolMetrics(t *testing.T) {
+	pool := NewPool(PoolConfig{Workers: 2, QueueSize: 10}, nil, nil)
+
+	metrics := pool.Metrics()
+	if metrics.QueueDepth != 0 {
+		t.Errorf("Expected queue depth 0, got %d", metrics.QueueDepth)
+	}
+	if metrics.InFlight != 0 {
+		t.Errorf("Expected in-flight 0, got %d", metrics.InFlight)
+	}
+	if metrics.DeadLetterCount != 0 {
+		t.Errorf("Expected dead-letter count 0, got %d", metrics.DeadLetterCount)
+	}
+}
+
+// failingJobStore rejects every Enqueue, simulating a store that's full or
+// unwritable so Submit has no choice but to drop the job.
+type failingJobStore struct{ memoryJobStore }
+
+func (s *failingJobStore) Enqueue(job *AuditJob) error {
+	return fmt.Errorf("store unavailable")
+}
+
+func TestPoolMetricsDroppedCount(t *testing.T) {
+	pool := NewPool(PoolConfig{Workers: 0, QueueSize: 0, Store: &failingJobStore{}, SubmitTimeout: time.Millisecond}, nil, nil)
+
+	pool.Submit(&AuditJob{RequestID: "req-1"})
+
+	if got := pool.Metrics().DroppedCount; got != 1 {
+		t.Errorf("Expected dropped count 1, got %d", got)
+	}
+}
+
 func TestAuditJob(t *testing.T) {
 	job := &AuditJob{
 		RequestID:   "req-123",
@@ -46,6 +84,17 @@ func TestAuditJob(t *testing.T) {
 	}
 }
 
+func TestToWSAuditResultCarriesPrincipal(t *testing.T) {
+	job := &AuditJob{RequestID: "req-1", Principal: "alice"}
+	result := &grpc.AuditResult{TrustScore: 0.9}
+
+	wsResult := toWSAuditResult(job, result, time.Second)
+
+	if wsResult.Principal != "alice" {
+		t.Errorf("Expected Principal 'alice', got %q", wsResult.Principal)
+	}
+}
+
 func TestTruncateString(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -69,7 +118,7 @@ func TestTruncateString(t *testing.T) {
 }
 
 func TestPoolSubmitWithNoClient(t *testing.T) {
-	pool := NewPool(1, 10, nil, nil)
+	pool := NewPool(PoolConfig{Workers: 1, QueueSize: 10}, nil, nil)
 	go pool.Start()
 	defer pool.Stop()
 
@@ -86,3 +135,52 @@ func TestPoolSubmitWithNoClient(t *testing.T) {
 	pool.Submit(job)
 	time.Sleep(100 * time.Millisecond)
 }
+
+// TestPoolSubmitDoesNotRaceStopClose drives concurrent Submit calls (as
+// retryOrDeadLetter's time.AfterFunc callbacks do) against a real Stop with
+// a tiny queue, so any send-on-closed-queue race would panic under -race.
+func TestPoolSubmitDoesNotRaceStopClose(t *testing.T) {
+	pool := NewPool(PoolConfig{Workers: 1, QueueSize: 1, SubmitTimeout: time.Millisecond}, nil, nil)
+	pool.Start()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pool.Submit(&AuditJob{RequestID: "race"})
+		}()
+	}
+	pool.Stop()
+	wg.Wait()
+}
+
+func TestCheckInFlightWithNoCheckerConfigured(t *testing.T) {
+	pool := NewPool(PoolConfig{Workers: 1, QueueSize: 10}, nil, nil)
+
+	if violation, _ := pool.CheckInFlight("anything"); violation {
+		t.Error("Expected no violation when no InFlightChecker is configured")
+	}
+}
+
+func TestCheckInFlightRunsConfiguredChecker(t *testing.T) {
+	pool := NewPool(PoolConfig{Workers: 1, QueueSize: 10}, nil, nil)
+	pool.SetInFlightChecker(func(content string) (bool, string) {
+		if strings.Contains(content, "blocked") {
+			return true, "contains blocked word"
+		}
+		return false, ""
+	})
+
+	if violation, _ := pool.CheckInFlight("all clear"); violation {
+		t.Error("Expected no violation for clean content")
+	}
+
+	violation, reason := pool.CheckInFlight("this is blocked")
+	if !violation {
+		t.Fatal("Expected a violation for flagged content")
+	}
+	if reason != "contains blocked word" {
+		t.Errorf("Expected reason 'contains blocked word', got %q", reason)
+	}
+}
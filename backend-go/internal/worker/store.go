@@ -0,0 +1,260 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// JobStore persists AuditJobs that could not be accepted into the in-memory
+// queue so they survive a full queue and, for durable implementations, a
+// process restart.
+type JobStore interface {
+	// Enqueue persists job for later delivery.
+	Enqueue(job *AuditJob) error
+	// Dequeue returns the oldest pending job, if any. ok is false when the
+	// store is empty.
+	Dequeue() (job *AuditJob, ok bool, err error)
+	// Ack removes a job that finished processing successfully.
+	Ack(requestID string) error
+	// DeadLetter records a job that permanently failed after exhausting retries.
+	DeadLetter(job *AuditJob, reason string) error
+	// Recover requeues any jobs left in an in-flight state by a prior crash.
+	Recover() error
+	// DeadLetterCount reports how many jobs have been dead-lettered.
+	DeadLetterCount() int
+	// Close releases any resources held by the store.
+	Close() error
+}
+
+// memoryJobStore is the original in-memory behavior: jobs that overflow here
+// are not retained across a restart. It exists mainly so Pool always has a
+// non-nil JobStore to call into.
+type memoryJobStore struct {
+	mu         sync.Mutex
+	pending    []*AuditJob
+	deadLetter int
+}
+
+func newMemoryJobStore() *memoryJobStore {
+	return &memoryJobStore{}
+}
+
+func (s *memoryJobStore) Enqueue(job *AuditJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending = append(s.pending, job)
+	return nil
+}
+
+func (s *memoryJobStore) Dequeue() (*AuditJob, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.pending) == 0 {
+		return nil, false, nil
+	}
+	job := s.pending[0]
+	s.pending = s.pending[1:]
+	return job, true, nil
+}
+
+func (s *memoryJobStore) Ack(requestID string) error { return nil }
+
+func (s *memoryJobStore) DeadLetter(job *AuditJob, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deadLetter++
+	return nil
+}
+
+func (s *memoryJobStore) Recover() error { return nil }
+
+func (s *memoryJobStore) DeadLetterCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deadLetter
+}
+
+func (s *memoryJobStore) Close() error { return nil }
+
+// fileJobStore is a disk-backed WAL: each pending job is one JSON file under
+// <dir>/pending. Dequeue moves the oldest file into <dir>/inflight so a crash
+// mid-processing can be recovered with Recover, which moves inflight files
+// back to pending. DeadLetter moves the file (plus the failure reason) to
+// <dir>/deadletter instead of deleting it.
+type fileJobStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+// NewFileJobStore creates (if needed) dir/pending, dir/inflight and
+// dir/deadletter and returns a JobStore backed by them.
+func NewFileJobStore(dir string) (JobStore, error) {
+	for _, sub := range []string{"pending", "inflight", "deadletter"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create job store directory %s: %w", sub, err)
+		}
+	}
+	return &fileJobStore{dir: dir}, nil
+}
+
+// sanitizeRequestID strips everything but a safe filename alphabet from
+// requestID. RequestID usually comes straight off the client-supplied
+// X-Request-ID header (see proxy.Handler), so it must never be concatenated
+// into a filesystem path unsanitized - an attacker could otherwise send
+// something like "../../../../tmp/pwned" to write a job file outside dir.
+func sanitizeRequestID(requestID string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, requestID)
+}
+
+func (s *fileJobStore) pendingPath(requestID string) string {
+	return filepath.Join(s.dir, "pending", sanitizeRequestID(requestID)+".json")
+}
+
+func (s *fileJobStore) inflightPath(requestID string) string {
+	return filepath.Join(s.dir, "inflight", sanitizeRequestID(requestID)+".json")
+}
+
+func (s *fileJobStore) deadLetterPath(requestID string) string {
+	return filepath.Join(s.dir, "deadletter", sanitizeRequestID(requestID)+".json")
+}
+
+func (s *fileJobStore) Enqueue(job *AuditJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job %s: %w", job.RequestID, err)
+	}
+	return os.WriteFile(s.pendingPath(job.RequestID), data, 0o644)
+}
+
+func (s *fileJobStore) Dequeue() (*AuditJob, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(filepath.Join(s.dir, "pending"))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to list pending jobs: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, false, nil
+	}
+
+	// Pick the file with the oldest mtime, not the oldest name: name is
+	// sanitizeRequestID(RequestID)+".json", and RequestID is client-supplied
+	// (or a random UUID) with no time ordering, so picking by name replayed
+	// jobs in roughly-random order and let a caller bias replay order via
+	// X-Request-ID. mtime reflects actual enqueue order instead. This is a
+	// single linear scan for the minimum rather than a full sort, since only
+	// the oldest entry is ever needed; ties (mtime resolution coarser than
+	// the enqueue rate) break on name for a deterministic, reproducible pick.
+	name := entries[0].Name()
+	oldest, oldestErr := entries[0].Info()
+	for _, entry := range entries[1:] {
+		info, err := entry.Info()
+		switch {
+		case err != nil || oldestErr != nil:
+			if entry.Name() < name {
+				name, oldest, oldestErr = entry.Name(), info, err
+			}
+		case info.ModTime().Before(oldest.ModTime()):
+			name, oldest, oldestErr = entry.Name(), info, err
+		case info.ModTime().Equal(oldest.ModTime()) && entry.Name() < name:
+			name, oldest, oldestErr = entry.Name(), info, err
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.dir, "pending", name))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read pending job %s: %w", name, err)
+	}
+
+	var job AuditJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal pending job %s: %w", name, err)
+	}
+
+	if err := os.Rename(filepath.Join(s.dir, "pending", name), s.inflightPath(job.RequestID)); err != nil {
+		return nil, false, fmt.Errorf("failed to move job %s to inflight: %w", job.RequestID, err)
+	}
+
+	return &job, true, nil
+}
+
+func (s *fileJobStore) Ack(requestID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := os.Remove(s.inflightPath(requestID))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to ack job %s: %w", requestID, err)
+	}
+	return nil
+}
+
+func (s *fileJobStore) DeadLetter(job *AuditJob, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := struct {
+		Job    *AuditJob `json:"job"`
+		Reason string    `json:"reason"`
+	}{Job: job, Reason: reason}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead-letter record for %s: %w", job.RequestID, err)
+	}
+
+	path := s.deadLetterPath(job.RequestID)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write dead-letter record for %s: %w", job.RequestID, err)
+	}
+
+	_ = os.Remove(s.inflightPath(job.RequestID))
+	_ = os.Remove(s.pendingPath(job.RequestID))
+	return nil
+}
+
+// Recover moves any jobs left in inflight/ (from a crash between Dequeue and
+// Ack/DeadLetter) back into pending/ so they are replayed.
+func (s *fileJobStore) Recover() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(filepath.Join(s.dir, "inflight"))
+	if err != nil {
+		return fmt.Errorf("failed to list inflight jobs: %w", err)
+	}
+
+	for _, entry := range entries {
+		src := filepath.Join(s.dir, "inflight", entry.Name())
+		dst := filepath.Join(s.dir, "pending", entry.Name())
+		if err := os.Rename(src, dst); err != nil {
+			return fmt.Errorf("failed to recover job %s: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+func (s *fileJobStore) DeadLetterCount() int {
+	entries, err := os.ReadDir(filepath.Join(s.dir, "deadletter"))
+	if err != nil {
+		return 0
+	}
+	return len(entries)
+}
+
+func (s *fileJobStore) Close() error { return nil }
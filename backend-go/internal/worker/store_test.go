@@ -0,0 +1,159 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMemoryJobStoreEnqueueDequeue(t *testing.T) {
+	store := newMemoryJobStore()
+
+	job := &AuditJob{RequestID: "req-1", Prompt: "p", Response: "r"}
+	if err := store.Enqueue(job); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+
+	got, ok, err := store.Dequeue()
+	if err != nil {
+		t.Fatalf("Dequeue failed: %v", err)
+	}
+	if !ok {
+		t.Fatal("Expected a job to be dequeued")
+	}
+	if got.RequestID != "req-1" {
+		t.Errorf("Expected RequestID 'req-1', got '%s'", got.RequestID)
+	}
+
+	_, ok, err = store.Dequeue()
+	if err != nil {
+		t.Fatalf("Dequeue failed: %v", err)
+	}
+	if ok {
+		t.Error("Expected store to be empty after draining")
+	}
+}
+
+func TestMemoryJobStoreDeadLetterCount(t *testing.T) {
+	store := newMemoryJobStore()
+
+	if err := store.DeadLetter(&AuditJob{RequestID: "req-1"}, "audit failed"); err != nil {
+		t.Fatalf("DeadLetter failed: %v", err)
+	}
+
+	if store.DeadLetterCount() != 1 {
+		t.Errorf("Expected dead-letter count 1, got %d", store.DeadLetterCount())
+	}
+}
+
+func TestFileJobStoreRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileJobStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileJobStore failed: %v", err)
+	}
+	defer store.Close()
+
+	job := &AuditJob{RequestID: "req-2", Prompt: "p", Response: "r"}
+	if err := store.Enqueue(job); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+
+	got, ok, err := store.Dequeue()
+	if err != nil || !ok {
+		t.Fatalf("Dequeue failed: ok=%v err=%v", ok, err)
+	}
+	if got.RequestID != "req-2" {
+		t.Errorf("Expected RequestID 'req-2', got '%s'", got.RequestID)
+	}
+
+	// A job left inflight (no Ack/DeadLetter yet) should be recovered back
+	// into pending by a fresh store pointed at the same directory.
+	recovered, err := NewFileJobStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileJobStore (reopen) failed: %v", err)
+	}
+	defer recovered.Close()
+
+	if err := recovered.Recover(); err != nil {
+		t.Fatalf("Recover failed: %v", err)
+	}
+
+	replayed, ok, err := recovered.Dequeue()
+	if err != nil || !ok {
+		t.Fatalf("Expected recovered job to be replayed: ok=%v err=%v", ok, err)
+	}
+	if replayed.RequestID != "req-2" {
+		t.Errorf("Expected recovered RequestID 'req-2', got '%s'", replayed.RequestID)
+	}
+
+	if err := recovered.Ack("req-2"); err != nil {
+		t.Fatalf("Ack failed: %v", err)
+	}
+}
+
+// TestFileJobStoreDequeueOrdersByEnqueueTimeNotRequestID guards against a
+// regression where Dequeue sorted pending files by name - i.e. by
+// sanitizeRequestID(RequestID) - instead of enqueue order. RequestID has no
+// time ordering (it's a client-supplied header or a random UUID), so that
+// let whichever RequestID sorted first alphabetically jump the queue
+// regardless of when it was actually enqueued.
+func TestFileJobStoreDequeueOrdersByEnqueueTimeNotRequestID(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileJobStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileJobStore failed: %v", err)
+	}
+	defer store.Close()
+
+	// "zzz-first" is enqueued first but sorts last alphabetically; "aaa-second"
+	// is enqueued second but sorts first. A name-based sort would dequeue
+	// "aaa-second" ahead of "zzz-first".
+	if err := store.Enqueue(&AuditJob{RequestID: "zzz-first", Prompt: "p", Response: "r"}); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+	time.Sleep(10 * time.Millisecond) // ensure a distinct, later mtime
+	if err := store.Enqueue(&AuditJob{RequestID: "aaa-second", Prompt: "p", Response: "r"}); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+
+	got, ok, err := store.Dequeue()
+	if err != nil || !ok {
+		t.Fatalf("Dequeue failed: ok=%v err=%v", ok, err)
+	}
+	if got.RequestID != "zzz-first" {
+		t.Errorf("Expected the job enqueued first ('zzz-first') to dequeue first, got %q", got.RequestID)
+	}
+}
+
+// TestFileJobStoreRejectsPathTraversalInRequestID guards against a
+// regression where RequestID - which comes straight off the client-supplied
+// X-Request-ID header - was concatenated unsanitized into a filesystem path,
+// letting a crafted header write a job file outside the store directory.
+func TestFileJobStoreRejectsPathTraversalInRequestID(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileJobStore(dir)
+	if err != nil {
+		t.Fatalf("NewFileJobStore failed: %v", err)
+	}
+	defer store.Close()
+
+	escapeDir := t.TempDir()
+	job := &AuditJob{RequestID: "../../../../../../" + escapeDir + "/pwned", Prompt: "p", Response: "r"}
+	if err := store.Enqueue(job); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(escapeDir, "pwned.json")); err == nil {
+		t.Fatal("Enqueue wrote a job file outside the store directory")
+	}
+
+	got, ok, err := store.Dequeue()
+	if err != nil || !ok {
+		t.Fatalf("Expected the sanitized job to still be dequeueable: ok=%v err=%v", ok, err)
+	}
+	if got.RequestID != job.RequestID {
+		t.Errorf("Expected dequeued RequestID %q, got %q", job.RequestID, got.RequestID)
+	}
+}